@@ -0,0 +1,644 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ack implements a garbage-collected and snapshottable store of alert
+// acknowledgements. The acknowledgements are gossiped to the other peers of
+// the cluster and applied to the alert marker.
+package ack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/matttproud/golang_protobuf_extensions/pbutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	pb "github.com/prometheus/alertmanager/ack/ackpb"
+	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// ErrNotFound is returned if an acknowledgement was not found.
+var ErrNotFound = errors.New("acknowledgement not found")
+
+// ErrInvalidState is returned if the state isn't valid.
+var ErrInvalidState = errors.New("invalid state")
+
+// Acks holds the acknowledgements of alerts.
+type Acks struct {
+	clock clock.Clock
+
+	logger    log.Logger
+	metrics   *metrics
+	retention time.Duration
+	marker    types.Marker
+
+	mtx       sync.RWMutex
+	st        state
+	broadcast func([]byte)
+}
+
+// MaintenanceFunc represents the function to run as part of the periodic
+// maintenance for the acknowledgements. It returns the size of the snapshot
+// taken or an error if it failed.
+type MaintenanceFunc func() (int64, error)
+
+type metrics struct {
+	gcDuration              prometheus.Summary
+	snapshotDuration        prometheus.Summary
+	snapshotSize            prometheus.Gauge
+	acksActive              prometheus.GaugeFunc
+	propagatedMessagesTotal prometheus.Counter
+	maintenanceTotal        prometheus.Counter
+	maintenanceErrorsTotal  prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer, a *Acks) *metrics {
+	m := &metrics{}
+
+	m.gcDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_acks_gc_duration_seconds",
+		Help:       "Duration of the last acknowledgement garbage collection cycle.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotDuration = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "alertmanager_acks_snapshot_duration_seconds",
+		Help:       "Duration of the last acknowledgement snapshot.",
+		Objectives: map[float64]float64{},
+	})
+	m.snapshotSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "alertmanager_acks_snapshot_size_bytes",
+		Help: "Size of the last acknowledgement snapshot in bytes.",
+	})
+	m.acksActive = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "alertmanager_acks",
+		Help: "How many alerts are currently acknowledged.",
+	}, func() float64 {
+		return float64(a.count())
+	})
+	m.maintenanceTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acks_maintenance_total",
+		Help: "How many maintenances were executed for acknowledgements.",
+	})
+	m.maintenanceErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acks_maintenance_errors_total",
+		Help: "How many maintenances were executed for acknowledgements that failed.",
+	})
+	m.propagatedMessagesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_acks_gossip_messages_propagated_total",
+		Help: "Number of received gossip messages that have been further gossiped.",
+	})
+
+	if r != nil {
+		r.MustRegister(
+			m.gcDuration,
+			m.snapshotDuration,
+			m.snapshotSize,
+			m.acksActive,
+			m.propagatedMessagesTotal,
+			m.maintenanceTotal,
+			m.maintenanceErrorsTotal,
+		)
+	}
+	return m
+}
+
+type state map[model.Fingerprint]*pb.MeshAck
+
+// merge returns true or false whether the MeshAck was merged or
+// not. This information is used to decide to gossip the message further.
+func (s state) merge(e *pb.MeshAck, now time.Time) bool {
+	if !e.ExpiresAt.IsZero() && e.ExpiresAt.Before(now) {
+		return false
+	}
+	fp := model.Fingerprint(e.Ack.Fingerprint)
+
+	prev, ok := s[fp]
+	if !ok || prev.Ack.UpdatedAt.Before(e.Ack.UpdatedAt) {
+		s[fp] = e
+		return true
+	}
+	return false
+}
+
+func (s state) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, e := range s {
+		if _, err := pbutil.WriteDelimited(&buf, e); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeState(r io.Reader) (state, error) {
+	st := state{}
+	for {
+		var e pb.MeshAck
+		_, err := pbutil.ReadDelimited(r, &e)
+		if err == nil {
+			if e.Ack == nil {
+				return nil, ErrInvalidState
+			}
+			st[model.Fingerprint(e.Ack.Fingerprint)] = &e
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		return nil, err
+	}
+	return st, nil
+}
+
+func marshalMeshAck(e *pb.MeshAck) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := pbutil.WriteDelimited(&buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Options configures a new Acks implementation.
+type Options struct {
+	SnapshotReader io.Reader
+	SnapshotFile   string
+
+	// Retention is the time for which removed acknowledgements are kept.
+	// Acknowledgements without expiry are kept until the alert resolves.
+	Retention time.Duration
+
+	// Marker is updated with the acknowledgements of the alerts, if set.
+	Marker types.Marker
+
+	Logger  log.Logger
+	Metrics prometheus.Registerer
+}
+
+func (o *Options) validate() error {
+	if o.SnapshotFile != "" && o.SnapshotReader != nil {
+		return errors.New("only one of SnapshotFile and SnapshotReader must be set")
+	}
+
+	return nil
+}
+
+// New creates a new acknowledgement store based on the provided options.
+// The snapshot is loaded into the store if it is set.
+func New(o Options) (*Acks, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+
+	a := &Acks{
+		clock:     clock.New(),
+		retention: o.Retention,
+		marker:    o.Marker,
+		logger:    log.NewNopLogger(),
+		st:        state{},
+		broadcast: func([]byte) {},
+	}
+	a.metrics = newMetrics(o.Metrics, a)
+
+	if o.Logger != nil {
+		a.logger = o.Logger
+	}
+
+	if o.SnapshotFile != "" {
+		if r, err := os.Open(o.SnapshotFile); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			level.Debug(a.logger).Log("msg", "acknowledgements snapshot file doesn't exist", "err", err)
+		} else {
+			o.SnapshotReader = r
+			defer r.Close()
+		}
+	}
+
+	if o.SnapshotReader != nil {
+		if err := a.loadSnapshot(o.SnapshotReader); err != nil {
+			return a, err
+		}
+	}
+
+	return a, nil
+}
+
+func (a *Acks) now() time.Time {
+	return a.clock.Now()
+}
+
+// Maintenance garbage collects the acknowledgements at the given interval. If
+// the snapshot file is set, a snapshot is written to it afterwards.
+// Terminates on receiving from stopc.
+// If not nil, the last argument is an override for what to do as part of the maintenance - for advanced usage.
+func (a *Acks) Maintenance(interval time.Duration, snapf string, stopc <-chan struct{}, override MaintenanceFunc) {
+	if interval == 0 || stopc == nil {
+		level.Error(a.logger).Log("msg", "interval or stop signal are missing - not running maintenance")
+		return
+	}
+	t := a.clock.Ticker(interval)
+	defer t.Stop()
+
+	var doMaintenance MaintenanceFunc
+	doMaintenance = func() (int64, error) {
+		var size int64
+		if _, err := a.GC(); err != nil {
+			return size, err
+		}
+		if snapf == "" {
+			return size, nil
+		}
+		f, err := openReplace(snapf)
+		if err != nil {
+			return size, err
+		}
+		if size, err = a.Snapshot(f); err != nil {
+			f.Close()
+			return size, err
+		}
+		return size, f.Close()
+	}
+
+	if override != nil {
+		doMaintenance = override
+	}
+
+	runMaintenance := func(do func() (int64, error)) error {
+		a.metrics.maintenanceTotal.Inc()
+		start := a.now().UTC()
+		level.Debug(a.logger).Log("msg", "Running maintenance")
+		size, err := do()
+		a.metrics.snapshotSize.Set(float64(size))
+		if err != nil {
+			a.metrics.maintenanceErrorsTotal.Inc()
+			return err
+		}
+		level.Debug(a.logger).Log("msg", "Maintenance done", "duration", a.now().Sub(start), "size", size)
+		return nil
+	}
+
+Loop:
+	for {
+		select {
+		case <-stopc:
+			break Loop
+		case <-t.C:
+			if err := runMaintenance(doMaintenance); err != nil {
+				level.Error(a.logger).Log("msg", "Running maintenance failed", "err", err)
+			}
+		}
+	}
+
+	// No need to run final maintenance if we don't want to snapshot.
+	if snapf == "" {
+		return
+	}
+	if err := runMaintenance(doMaintenance); err != nil {
+		level.Error(a.logger).Log("msg", "Creating shutdown snapshot failed", "err", err)
+	}
+}
+
+// toAcknowledgement converts the stored acknowledgement. It returns nil for
+// removed or expired acknowledgements.
+func toAcknowledgement(ack *pb.Ack, now time.Time) *types.Acknowledgement {
+	if ack.Removed {
+		return nil
+	}
+	res := &types.Acknowledgement{
+		AcknowledgedBy: ack.AcknowledgedBy,
+		Comment:        ack.Comment,
+		CreatedAt:      ack.CreatedAt,
+		ExpiresAt:      ack.ExpiresAt,
+	}
+	if res.Expired(now) {
+		return nil
+	}
+	return res
+}
+
+// mark updates the marker with the given acknowledgement.
+func (a *Acks) mark(ack *pb.Ack, now time.Time) {
+	if a.marker == nil {
+		return
+	}
+	a.marker.SetAcknowledged(model.Fingerprint(ack.Fingerprint), toAcknowledgement(ack, now))
+}
+
+// set stores the acknowledgement and gossips it. It must be called with the
+// lock held.
+func (a *Acks) set(ack *pb.Ack, now time.Time) error {
+	// Acknowledgements without expiry are only removed once the alert
+	// resolves, which leaves a tombstone kept for the retention time.
+	var expiresAt time.Time
+	switch {
+	case ack.Removed:
+		expiresAt = now.Add(a.retention)
+	case !ack.ExpiresAt.IsZero():
+		expiresAt = ack.ExpiresAt
+	}
+	e := &pb.MeshAck{
+		Ack:       ack,
+		ExpiresAt: expiresAt,
+	}
+
+	b, err := marshalMeshAck(e)
+	if err != nil {
+		return err
+	}
+	// Local changes always replace the previous state, even if they happen
+	// within the same clock tick.
+	a.st[model.Fingerprint(ack.Fingerprint)] = e
+	a.mark(ack, now)
+	a.broadcast(b)
+
+	return nil
+}
+
+// Acknowledge acknowledges the alert with the given fingerprint, replacing
+// any previous acknowledgement of it. A zero expiresAt means that the
+// acknowledgement never expires.
+func (a *Acks) Acknowledge(fp model.Fingerprint, by, comment string, expiresAt time.Time) (*types.Acknowledgement, error) {
+	now := a.now()
+	if by == "" {
+		return nil, errors.New("missing acknowledging user")
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, fmt.Errorf("expiry time %s is in the past", expiresAt)
+	}
+
+	ack := &pb.Ack{
+		Fingerprint:    uint64(fp),
+		AcknowledgedBy: by,
+		Comment:        comment,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+		UpdatedAt:      now,
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if err := a.set(ack, now); err != nil {
+		return nil, err
+	}
+	return toAcknowledgement(ack, now), nil
+}
+
+// Unacknowledge removes the acknowledgement of the alert with the given
+// fingerprint.
+func (a *Acks) Unacknowledge(fp model.Fingerprint) error {
+	now := a.now()
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.remove(fp, now)
+}
+
+// remove replaces the acknowledgement of the alert with a tombstone. It must
+// be called with the lock held.
+func (a *Acks) remove(fp model.Fingerprint, now time.Time) error {
+	prev, ok := a.st[fp]
+	if !ok || toAcknowledgement(prev.Ack, now) == nil {
+		return ErrNotFound
+	}
+
+	return a.set(&pb.Ack{
+		Fingerprint: uint64(fp),
+		CreatedAt:   prev.Ack.CreatedAt,
+		UpdatedAt:   now,
+		Removed:     true,
+	}, now)
+}
+
+// PreStore implements the mem.AlertStoreCallback interface.
+func (a *Acks) PreStore(_ *types.Alert, _ bool) error { return nil }
+
+// PostStore implements the mem.AlertStoreCallback interface. The
+// acknowledgement of the alert is removed once the alert resolves, or if the
+// alert started firing again after it was acknowledged, so that the next
+// firing of the alert is notified as usual.
+func (a *Acks) PostStore(alert *types.Alert, _ bool) {
+	fp := alert.Fingerprint()
+	now := a.now()
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	e, ok := a.st[fp]
+	if !ok || e.Ack.Removed {
+		return
+	}
+	if !alert.Resolved() && !alert.StartsAt.After(e.Ack.CreatedAt) {
+		return
+	}
+	if err := a.remove(fp, now); err != nil && !errors.Is(err, ErrNotFound) {
+		level.Error(a.logger).Log("msg", "Failed to remove acknowledgement of resolved alert", "alert", alert.Name(), "err", err)
+	}
+}
+
+// PostDelete implements the mem.AlertStoreCallback interface. The
+// acknowledgement of an alert that resolved and was garbage collected is
+// removed.
+func (a *Acks) PostDelete(alert *types.Alert) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if err := a.remove(alert.Fingerprint(), a.now()); err != nil && !errors.Is(err, ErrNotFound) {
+		level.Error(a.logger).Log("msg", "Failed to remove acknowledgement of resolved alert", "alert", alert.Name(), "err", err)
+	}
+}
+
+// Get returns the acknowledgement of the alert with the given fingerprint.
+func (a *Acks) Get(fp model.Fingerprint) (*types.Acknowledgement, error) {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	if e, ok := a.st[fp]; ok {
+		if ack := toAcknowledgement(e.Ack, a.now()); ack != nil {
+			return ack, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (a *Acks) count() int {
+	now := a.now()
+
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	var n int
+	for _, e := range a.st {
+		if toAcknowledgement(e.Ack, now) != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// GC removes expired acknowledgements and tombstones from the state. It
+// returns the number of removed entries. Acknowledgements without expiry are
+// kept until the alert resolves.
+func (a *Acks) GC() (int, error) {
+	start := time.Now()
+	defer func() { a.metrics.gcDuration.Observe(time.Since(start).Seconds()) }()
+
+	now := a.now()
+	var n int
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for fp, e := range a.st {
+		if e.ExpiresAt.IsZero() {
+			if e.Ack.Removed || !e.Ack.ExpiresAt.IsZero() {
+				return n, errors.New("unexpected zero expiration timestamp")
+			}
+			continue
+		}
+		if !e.ExpiresAt.After(now) {
+			delete(a.st, fp)
+			if a.marker != nil {
+				a.marker.SetAcknowledged(fp, nil)
+			}
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// loadSnapshot loads a snapshot generated by Snapshot() into the state.
+func (a *Acks) loadSnapshot(r io.Reader) error {
+	st, err := decodeState(r)
+	if err != nil {
+		return err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	now := a.now()
+	a.st = st
+	for _, e := range st {
+		a.mark(e.Ack, now)
+	}
+
+	return nil
+}
+
+// Snapshot writes the full internal state into the writer and returns the number of bytes
+// written.
+func (a *Acks) Snapshot(w io.Writer) (int64, error) {
+	start := time.Now()
+	defer func() { a.metrics.snapshotDuration.Observe(time.Since(start).Seconds()) }()
+
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	b, err := a.st.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+
+	return io.Copy(w, bytes.NewReader(b))
+}
+
+// MarshalBinary serializes all acknowledgements.
+func (a *Acks) MarshalBinary() ([]byte, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.st.MarshalBinary()
+}
+
+// Merge merges acknowledgement state received from the cluster with the local state.
+func (a *Acks) Merge(b []byte) error {
+	st, err := decodeState(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	now := a.now()
+
+	for _, e := range st {
+		if merged := a.st.merge(e, now); merged {
+			a.mark(e.Ack, now)
+			if !cluster.OversizedMessage(b) {
+				// If this is the first we've seen the message and it's
+				// not oversized, gossip it to other nodes. We don't
+				// propagate oversized messages because they're sent to
+				// all nodes already.
+				a.broadcast(b)
+				a.metrics.propagatedMessagesTotal.Inc()
+				level.Debug(a.logger).Log("msg", "Gossiping new acknowledgement", "ack", e)
+			}
+		}
+	}
+	return nil
+}
+
+// SetBroadcast sets a broadcast callback that will be invoked with serialized state
+// on updates.
+func (a *Acks) SetBroadcast(f func([]byte)) {
+	a.mtx.Lock()
+	a.broadcast = f
+	a.mtx.Unlock()
+}
+
+// replaceFile wraps a file that is moved to another filename on closing.
+type replaceFile struct {
+	*os.File
+	filename string
+}
+
+func (f *replaceFile) Close() error {
+	if err := f.File.Sync(); err != nil {
+		return err
+	}
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.File.Name(), f.filename)
+}
+
+// openReplace opens a new temporary file that is moved to filename on closing.
+func openReplace(filename string) (*replaceFile, error) {
+	tmpFilename := fmt.Sprintf("%s.%x", filename, uint64(rand.Int63()))
+
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	rf := &replaceFile{
+		File:     f,
+		filename: filename,
+	}
+	return rf, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ack
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newTestAcks(t *testing.T, c clock.Clock, mk types.Marker) *Acks {
+	t.Helper()
+
+	a, err := New(Options{Retention: time.Hour, Marker: mk})
+	require.NoError(t, err)
+	a.clock = c
+	return a
+}
+
+func TestAcknowledge(t *testing.T) {
+	mockClock := clock.NewMock()
+	marker := types.NewMarker(prometheus.NewRegistry())
+	a := newTestAcks(t, mockClock, marker)
+
+	_, err := a.Acknowledge(1, "", "", time.Time{})
+	require.EqualError(t, err, "missing acknowledging user")
+	_, err = a.Acknowledge(1, "me", "", mockClock.Now())
+	require.Error(t, err)
+
+	ack, err := a.Acknowledge(1, "me", "on it", time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "me", ack.AcknowledgedBy)
+
+	got, err := a.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, ack, got)
+	_, acked := marker.Acknowledged(1)
+	require.True(t, acked)
+
+	require.NoError(t, a.Unacknowledge(1))
+	_, err = a.Get(1)
+	require.Equal(t, ErrNotFound, err)
+	_, acked = marker.Acknowledged(1)
+	require.False(t, acked)
+
+	require.Equal(t, ErrNotFound, a.Unacknowledge(1))
+	require.Equal(t, ErrNotFound, a.Unacknowledge(2))
+}
+
+func TestAcksGC(t *testing.T) {
+	mockClock := clock.NewMock()
+	marker := types.NewMarker(prometheus.NewRegistry())
+	a := newTestAcks(t, mockClock, marker)
+
+	_, err := a.Acknowledge(1, "me", "", mockClock.Now().Add(time.Minute))
+	require.NoError(t, err)
+	_, err = a.Acknowledge(2, "me", "", time.Time{})
+	require.NoError(t, err)
+	_, err = a.Acknowledge(3, "me", "", time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, a.Unacknowledge(3))
+
+	// The expired acknowledgement isn't returned before it is collected.
+	mockClock.Add(time.Minute)
+	_, err = a.Get(1)
+	require.Equal(t, ErrNotFound, err)
+
+	n, err := a.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// Tombstones are kept for the retention time, acknowledgements without
+	// expiry until the alert resolves.
+	mockClock.Add(time.Hour)
+	n, err = a.GC()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Len(t, a.st, 1)
+	_, acked := marker.Acknowledged(2)
+	require.True(t, acked)
+}
+
+func TestAcksResolvedAlert(t *testing.T) {
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Now())
+	marker := types.NewMarker(prometheus.NewRegistry())
+	a := newTestAcks(t, mockClock, marker)
+
+	alerts, err := mem.NewAlerts(context.Background(), marker, 30*time.Minute, a, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	now := time.Now()
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: now.Add(-time.Minute),
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+	fp := alert.Fingerprint()
+	require.NoError(t, alerts.Put(alert))
+
+	_, err = a.Acknowledge(fp, "me", "", time.Time{})
+	require.NoError(t, err)
+
+	// Updates of the firing alert keep the acknowledgement, which isn't
+	// collected after the retention time.
+	mockClock.Add(2 * time.Hour)
+	_, err = a.GC()
+	require.NoError(t, err)
+	require.NoError(t, alerts.Put(alert))
+	_, acked := marker.Acknowledged(fp)
+	require.True(t, acked)
+
+	// The acknowledgement is removed when the alert resolves.
+	resolved := *alert
+	resolved.EndsAt = now.Add(-time.Second)
+	resolved.UpdatedAt = now.Add(time.Second)
+	require.NoError(t, alerts.Put(&resolved))
+	_, acked = marker.Acknowledged(fp)
+	require.False(t, acked)
+	_, err = a.Get(fp)
+	require.Equal(t, ErrNotFound, err)
+
+	// The alert firing again is not acknowledged.
+	refiring := *alert
+	refiring.StartsAt = now
+	refiring.UpdatedAt = now.Add(2 * time.Second)
+	require.NoError(t, alerts.Put(&refiring))
+	_, acked = marker.Acknowledged(fp)
+	require.False(t, acked)
+
+	// An alert that started firing again after it was acknowledged, without
+	// the resolution being seen, isn't acknowledged either.
+	_, err = a.Acknowledge(fp, "me", "", time.Time{})
+	require.NoError(t, err)
+	refiring.StartsAt = mockClock.Now().Add(time.Minute)
+	refiring.UpdatedAt = now.Add(3 * time.Second)
+	require.NoError(t, alerts.Put(&refiring))
+	_, acked = marker.Acknowledged(fp)
+	require.False(t, acked)
+
+	// Garbage collected alerts lose their acknowledgement.
+	_, err = a.Acknowledge(fp, "me", "", time.Time{})
+	require.NoError(t, err)
+	a.PostDelete(&refiring)
+	_, acked = marker.Acknowledged(fp)
+	require.False(t, acked)
+}
+
+func TestAcksSnapshot(t *testing.T) {
+	mockClock := clock.NewMock()
+	a := newTestAcks(t, mockClock, nil)
+
+	// The restored store uses the real clock.
+	_, err := a.Acknowledge(1, "me", "on it", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = a.Acknowledge(2, "you", "", time.Time{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = a.Snapshot(&buf)
+	require.NoError(t, err)
+
+	// Loading the snapshot restores the acknowledgements in the marker.
+	marker := types.NewMarker(prometheus.NewRegistry())
+	restored, err := New(Options{SnapshotReader: &buf, Marker: marker})
+	require.NoError(t, err)
+	require.Len(t, restored.st, 2)
+
+	ack, acked := marker.Acknowledged(1)
+	require.True(t, acked)
+	require.Equal(t, "on it", ack.Comment)
+	_, acked = marker.Acknowledged(2)
+	require.True(t, acked)
+}
+
+func TestAcksMerge(t *testing.T) {
+	mockClock := clock.NewMock()
+	marker := types.NewMarker(prometheus.NewRegistry())
+	a := newTestAcks(t, mockClock, marker)
+	peer := newTestAcks(t, mockClock, nil)
+
+	var broadcasts [][]byte
+	peer.SetBroadcast(func(b []byte) { broadcasts = append(broadcasts, b) })
+
+	_, err := peer.Acknowledge(1, "me", "", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, broadcasts, 1)
+
+	require.NoError(t, a.Merge(broadcasts[0]))
+	_, acked := marker.Acknowledged(1)
+	require.True(t, acked)
+
+	// The removal replaces the acknowledgement on the other peers.
+	mockClock.Add(time.Second)
+	require.NoError(t, peer.Unacknowledge(1))
+	require.Len(t, broadcasts, 2)
+
+	require.NoError(t, a.Merge(broadcasts[1]))
+	_, acked = marker.Acknowledged(1)
+	require.False(t, acked)
+
+	// Older state is ignored.
+	require.NoError(t, a.Merge(broadcasts[0]))
+	_, acked = marker.Acknowledged(1)
+	require.False(t, acked)
+}
@@ -25,12 +25,18 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/common/route"
 
+	"github.com/prometheus/alertmanager/ack"
 	apiv2 "github.com/prometheus/alertmanager/api/v2"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/events"
+	"github.com/prometheus/alertmanager/inhibit"
+	"github.com/prometheus/alertmanager/nflog"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -43,15 +49,32 @@ type API struct {
 	concurrencyLimitExceeded prometheus.Counter
 	timeout                  time.Duration
 	inFlightSem              chan struct{}
+	eventClientsSem          chan struct{}
 }
 
-// Options for the creation of an API object. Alerts, Silences, and StatusFunc
-// are mandatory to set. The zero value for everything else is a safe default.
+// defaultEventClients is the default maximum number of clients of the event
+// stream.
+const defaultEventClients = 64
+
+// Options for the creation of an API object. Alerts, Silences, Acks,
+// NotificationLog and StatusFunc are mandatory to set. The zero value for everything else is a safe default.
 type Options struct {
 	// Alerts to be used by the API. Mandatory.
 	Alerts provider.Alerts
 	// Silences to be used by the API. Mandatory.
 	Silences *silence.Silences
+	// Acks holds the alert acknowledgements managed by the API. Mandatory.
+	Acks *ack.Acks
+	// NotificationLog is queried for the notification history. Mandatory.
+	NotificationLog *nflog.Log
+	// DeadLetters holds the notifications that failed. If nil, no failed
+	// notification is reported.
+	DeadLetters *notify.DeadLetterStore
+	// CircuitBreakers of the integrations reported with the receivers. If
+	// nil, no circuit breaker state is reported.
+	CircuitBreakers *notify.CircuitBreakers
+	// Events are streamed by the API. If nil, the event stream is disabled.
+	Events *events.Hub
 	// StatusFunc is used be the API to retrieve the AlertStatus of an
 	// alert. Mandatory.
 	StatusFunc func(model.Fingerprint) types.AlertStatus
@@ -65,6 +88,10 @@ type Options struct {
 	// larger. Status code 503 is served for GET requests that would exceed
 	// the concurrency limit.
 	Concurrency int
+	// EventClients is the maximum number of clients of the event stream. The
+	// zero value (and negative values) result in a limit of 64. Status code
+	// 503 is served for requests that would exceed the limit.
+	EventClients int
 	// Logger is used for logging, if nil, no logging will happen.
 	Logger log.Logger
 	// Registry is used to register Prometheus metrics. If nil, no metrics
@@ -74,6 +101,10 @@ type Options struct {
 	// according to the current active configuration. Alerts returned are
 	// filtered by the arguments provided to the function.
 	GroupFunc func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string)
+	// LimitedFunc returns the receivers for which alerts were not added to
+	// an aggregation group because a limit was reached, by alert
+	// fingerprint. If nil, no alert is reported as limited.
+	LimitedFunc func() map[model.Fingerprint][]string
 }
 
 func (o Options) validate() error {
@@ -83,6 +114,12 @@ func (o Options) validate() error {
 	if o.Silences == nil {
 		return errors.New("mandatory field Silences not set")
 	}
+	if o.Acks == nil {
+		return errors.New("mandatory field Acks not set")
+	}
+	if o.NotificationLog == nil {
+		return errors.New("mandatory field NotificationLog not set")
+	}
 	if o.StatusFunc == nil {
 		return errors.New("mandatory field StatusFunc not set")
 	}
@@ -110,11 +147,22 @@ func New(opts Options) (*API, error) {
 		}
 	}
 
+	eventClients := opts.EventClients
+	if eventClients < 1 {
+		eventClients = defaultEventClients
+	}
+
 	v2, err := apiv2.NewAPI(
 		opts.Alerts,
 		opts.GroupFunc,
+		opts.LimitedFunc,
 		opts.StatusFunc,
 		opts.Silences,
+		opts.Acks,
+		opts.NotificationLog,
+		opts.DeadLetters,
+		opts.CircuitBreakers,
+		opts.Events,
 		opts.Peer,
 		log.With(l, "version", "v2"),
 		opts.Registry,
@@ -151,6 +199,7 @@ func New(opts Options) (*API, error) {
 		concurrencyLimitExceeded: concurrencyLimitExceeded,
 		timeout:                  opts.Timeout,
 		inFlightSem:              make(chan struct{}, concurrency),
+		eventClientsSem:          make(chan struct{}, eventClients),
 	}, nil
 }
 
@@ -180,14 +229,20 @@ func (api *API) Register(r *route.Router, routePrefix string) *http.ServeMux {
 		apiPrefix+"/api/v2/",
 		api.limitHandler(http.StripPrefix(apiPrefix, api.v2.Handler)),
 	)
+	// The event stream is long-lived, it is neither subject to the timeout
+	// nor to the concurrency limit but to its own limit of clients.
+	mux.Handle(
+		apiPrefix+"/api/v2/events",
+		api.eventClientsLimitHandler(http.StripPrefix(apiPrefix, api.v2.Handler)),
+	)
 
 	return mux
 }
 
 // Update config and resolve timeout of each API. APIv2 also needs
-// setAlertStatus to be updated.
-func (api *API) Update(cfg *config.Config, setAlertStatus func(model.LabelSet)) {
-	api.v2.Update(cfg, setAlertStatus)
+// the template, setAlertStatus and inhibitor to be updated.
+func (api *API) Update(cfg *config.Config, tmpl *template.Template, setAlertStatus func(model.LabelSet), inhibitor *inhibit.Inhibitor) {
+	api.v2.Update(cfg, tmpl, setAlertStatus, inhibitor)
 }
 
 func (api *API) limitHandler(h http.Handler) http.Handler {
@@ -217,3 +272,21 @@ func (api *API) limitHandler(h http.Handler) http.Handler {
 		"Exceeded configured timeout of %v.\n", api.timeout,
 	))
 }
+
+func (api *API) eventClientsLimitHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		select {
+		case api.eventClientsSem <- struct{}{}:
+			defer func() {
+				<-api.eventClientsSem
+			}()
+		default:
+			api.concurrencyLimitExceeded.Inc()
+			http.Error(rsp, fmt.Sprintf(
+				"Limit of event stream clients reached (%d), try again later.\n", cap(api.eventClientsSem),
+			), http.StatusServiceUnavailable)
+			return
+		}
+		h.ServeHTTP(rsp, req)
+	})
+}
@@ -14,6 +14,7 @@
 package v2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -33,6 +34,7 @@ import (
 	"github.com/prometheus/common/version"
 	"github.com/rs/cors"
 
+	"github.com/prometheus/alertmanager/ack"
 	"github.com/prometheus/alertmanager/api/metrics"
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
 	"github.com/prometheus/alertmanager/api/v2/restapi"
@@ -40,16 +42,26 @@ import (
 	alert_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
 	alertgroup_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/alertgroup"
 	general_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
+	inhibition_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/inhibition"
+	notification_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/notification"
 	receiver_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/config/receiver"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/events"
+	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/matchers/compat"
+	"github.com/prometheus/alertmanager/nflog"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/store"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/timeinterval"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -57,18 +69,27 @@ import (
 type API struct {
 	peer           cluster.ClusterPeer
 	silences       *silence.Silences
+	acks           *ack.Acks
+	nflog          *nflog.Log
+	deadLetters    *notify.DeadLetterStore
+	breakers       *notify.CircuitBreakers
+	events         *events.Hub
 	alerts         provider.Alerts
 	alertGroups    groupsFn
+	limitedAlerts  limitedAlertsFn
 	getAlertStatus getAlertStatusFn
 	uptime         time.Time
 
-	// mtx protects alertmanagerConfig, setAlertStatus and route.
+	// mtx protects alertmanagerConfig, tmpl, setAlertStatus, route and
+	// inhibitor.
 	mtx sync.RWMutex
 	// resolveTimeout represents the default resolve timeout that an alert is
 	// assigned if no end time is specified.
 	alertmanagerConfig *config.Config
+	tmpl               *template.Template
 	route              *dispatch.Route
 	setAlertStatus     setAlertStatusFn
+	inhibitor          *inhibit.Inhibitor
 
 	logger log.Logger
 	m      *metrics.Alerts
@@ -78,6 +99,7 @@ type API struct {
 
 type (
 	groupsFn         func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[prometheus_model.Fingerprint][]string)
+	limitedAlertsFn  func() map[prometheus_model.Fingerprint][]string
 	getAlertStatusFn func(prometheus_model.Fingerprint) types.AlertStatus
 	setAlertStatusFn func(prometheus_model.LabelSet)
 )
@@ -86,8 +108,14 @@ type (
 func NewAPI(
 	alerts provider.Alerts,
 	gf groupsFn,
+	lf limitedAlertsFn,
 	sf getAlertStatusFn,
 	silences *silence.Silences,
+	acks *ack.Acks,
+	nl *nflog.Log,
+	dl *notify.DeadLetterStore,
+	cb *notify.CircuitBreakers,
+	ev *events.Hub,
 	peer cluster.ClusterPeer,
 	l log.Logger,
 	r prometheus.Registerer,
@@ -96,8 +124,14 @@ func NewAPI(
 		alerts:         alerts,
 		getAlertStatus: sf,
 		alertGroups:    gf,
+		limitedAlerts:  lf,
 		peer:           peer,
 		silences:       silences,
+		acks:           acks,
+		nflog:          nl,
+		deadLetters:    dl,
+		breakers:       cb,
+		events:         ev,
 		logger:         l,
 		m:              metrics.NewAlerts(r),
 		uptime:         time.Now(),
@@ -123,16 +157,30 @@ func NewAPI(
 
 	openAPI.AlertGetAlertsHandler = alert_ops.GetAlertsHandlerFunc(api.getAlertsHandler)
 	openAPI.AlertPostAlertsHandler = alert_ops.PostAlertsHandlerFunc(api.postAlertsHandler)
+	openAPI.AlertGetAlertAckHandler = alert_ops.GetAlertAckHandlerFunc(api.getAlertAckHandler)
+	openAPI.AlertPostAlertAckHandler = alert_ops.PostAlertAckHandlerFunc(api.postAlertAckHandler)
+	openAPI.AlertDeleteAlertAckHandler = alert_ops.DeleteAlertAckHandlerFunc(api.deleteAlertAckHandler)
+	openAPI.AlertGetAlertExplanationHandler = alert_ops.GetAlertExplanationHandlerFunc(api.getAlertExplanationHandler)
 	openAPI.AlertgroupGetAlertGroupsHandler = alertgroup_ops.GetAlertGroupsHandlerFunc(api.getAlertGroupsHandler)
+	openAPI.NotificationGetNotificationsHandler = notification_ops.GetNotificationsHandlerFunc(api.getNotificationsHandler)
+	openAPI.NotificationGetDeadLettersHandler = notification_ops.GetDeadLettersHandlerFunc(api.getDeadLettersHandler)
+	openAPI.NotificationRedriveDeadLetterHandler = notification_ops.RedriveDeadLetterHandlerFunc(api.redriveDeadLetterHandler)
 	openAPI.GeneralGetStatusHandler = general_ops.GetStatusHandlerFunc(api.getStatusHandler)
+	openAPI.InhibitionGetInhibitionsHandler = inhibition_ops.GetInhibitionsHandlerFunc(api.getInhibitionsHandler)
 	openAPI.ReceiverGetReceiversHandler = receiver_ops.GetReceiversHandlerFunc(api.getReceiversHandler)
+	openAPI.ReceiverTestReceiverHandler = receiver_ops.TestReceiverHandlerFunc(api.testReceiverHandler)
 	openAPI.SilenceDeleteSilenceHandler = silence_ops.DeleteSilenceHandlerFunc(api.deleteSilenceHandler)
 	openAPI.SilenceGetSilenceHandler = silence_ops.GetSilenceHandlerFunc(api.getSilenceHandler)
+	openAPI.SilenceGetSilenceHistoryHandler = silence_ops.GetSilenceHistoryHandlerFunc(api.getSilenceHistoryHandler)
 	openAPI.SilenceGetSilencesHandler = silence_ops.GetSilencesHandlerFunc(api.getSilencesHandler)
 	openAPI.SilencePostSilencesHandler = silence_ops.PostSilencesHandlerFunc(api.postSilencesHandler)
+	openAPI.SilencePreviewSilenceHandler = silence_ops.PreviewSilenceHandlerFunc(api.previewSilenceHandler)
 
 	handleCORS := cors.Default().Handler
-	api.Handler = handleCORS(setResponseHeaders(openAPI.Serve(nil)))
+	mux := http.NewServeMux()
+	mux.Handle("/", openAPI.Serve(nil))
+	mux.HandleFunc("/api/v2/events", api.eventsHandler)
+	api.Handler = handleCORS(setResponseHeaders(mux))
 
 	return &api, nil
 }
@@ -155,13 +203,15 @@ func (api *API) requestLogger(req *http.Request) log.Logger {
 }
 
 // Update sets the API struct members that may change between reloads of alertmanager.
-func (api *API) Update(cfg *config.Config, setAlertStatus setAlertStatusFn) {
+func (api *API) Update(cfg *config.Config, tmpl *template.Template, setAlertStatus setAlertStatusFn, inhibitor *inhibit.Inhibitor) {
 	api.mtx.Lock()
 	defer api.mtx.Unlock()
 
 	api.alertmanagerConfig = cfg
+	api.tmpl = tmpl
 	api.route = dispatch.NewRoute(cfg.Route, nil)
 	api.setAlertStatus = setAlertStatus
+	api.inhibitor = inhibitor
 }
 
 func (api *API) getStatusHandler(params general_ops.GetStatusParams) middleware.Responder {
@@ -226,12 +276,122 @@ func (api *API) getReceiversHandler(params receiver_ops.GetReceiversParams) midd
 
 	receivers := make([]*open_api_models.Receiver, 0, len(api.alertmanagerConfig.Receivers))
 	for i := range api.alertmanagerConfig.Receivers {
-		receivers = append(receivers, &open_api_models.Receiver{Name: &api.alertmanagerConfig.Receivers[i].Name})
+		name := api.alertmanagerConfig.Receivers[i].Name
+		receiver := &open_api_models.Receiver{Name: &name}
+		for _, s := range api.breakers.Status(name) {
+			receiver.Integrations = append(receiver.Integrations, CircuitBreakerStatusToOpenAPI(s))
+		}
+		receivers = append(receivers, receiver)
 	}
 
 	return receiver_ops.NewGetReceiversOK().WithPayload(receivers)
 }
 
+// receiverTestTimeout bounds the time to send a test notification.
+const receiverTestTimeout = 30 * time.Second
+
+func (api *API) testReceiverHandler(params receiver_ops.TestReceiverParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	api.mtx.RLock()
+	var rcv *config.Receiver
+	for i := range api.alertmanagerConfig.Receivers {
+		if api.alertmanagerConfig.Receivers[i].Name == params.Name {
+			rcv = &api.alertmanagerConfig.Receivers[i]
+			break
+		}
+	}
+	tmpl := api.tmpl
+	resolveTimeout := time.Duration(api.alertmanagerConfig.Global.ResolveTimeout)
+	api.mtx.RUnlock()
+
+	if rcv == nil {
+		return receiver_ops.NewTestReceiverNotFound()
+	}
+
+	now := time.Now()
+	alerts := OpenAPIAlertsToAlerts(params.Alerts)
+	if len(alerts) == 0 {
+		alerts = []*types.Alert{{
+			Alert: prometheus_model.Alert{
+				Labels: prometheus_model.LabelSet{
+					prometheus_model.AlertNameLabel: "TestAlert",
+					"instance":                      "Alertmanager",
+				},
+				Annotations: prometheus_model.LabelSet{
+					"summary":     "Notification test",
+					"description": "Test notification sent to verify the configuration of the receiver.",
+				},
+			},
+		}}
+	}
+	for _, a := range alerts {
+		a.UpdatedAt = now
+		if a.StartsAt.IsZero() {
+			a.StartsAt = now
+		}
+		if a.EndsAt.IsZero() {
+			a.EndsAt = now.Add(resolveTimeout)
+		}
+		removeEmptyLabels(a.Labels)
+		if err := a.Validate(); err != nil {
+			return receiver_ops.NewTestReceiverBadRequest().WithPayload(err.Error())
+		}
+	}
+
+	integrations, err := receiver.BuildReceiverIntegrations(*rcv, tmpl, logger)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to build receiver integrations", "err", err)
+		return receiver_ops.NewTestReceiverInternalServerError().WithPayload(err.Error())
+	}
+
+	// The test notification is grouped by the labels common to all alerts.
+	groupLabels := prometheus_model.LabelSet{}
+	for _, kv := range tmpl.Data(rcv.Name, nil, alerts...).CommonLabels.SortedPairs() {
+		groupLabels[prometheus_model.LabelName(kv.Name)] = prometheus_model.LabelValue(kv.Value)
+	}
+
+	ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), receiverTestTimeout)
+	defer cancel()
+	ctx = notify.WithReceiverName(ctx, rcv.Name)
+	ctx = notify.WithGroupKey(ctx, fmt.Sprintf("{}/{receiver_test=%q}:%s", rcv.Name, groupLabels))
+	ctx = notify.WithGroupLabels(ctx, groupLabels)
+	ctx = notify.WithNow(ctx, now)
+
+	res := &open_api_models.ReceiverTestResult{
+		Receiver:     &rcv.Name,
+		Integrations: make([]*open_api_models.IntegrationTestResult, len(integrations)),
+	}
+	var wg sync.WaitGroup
+	for i := range integrations {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			integration := integrations[i]
+
+			start := time.Now()
+			_, err := integration.Notify(ctx, alerts...)
+			latency := time.Since(start).Seconds()
+
+			name, idx, success := integration.Name(), int64(integration.Index()), err == nil
+			r := &open_api_models.IntegrationTestResult{
+				Name:           &name,
+				Index:          &idx,
+				Success:        &success,
+				LatencySeconds: &latency,
+			}
+			if err != nil {
+				level.Debug(logger).Log("msg", "Test notification failed", "receiver", rcv.Name, "integration", integration.String(), "err", err)
+				r.Error = err.Error()
+			}
+			res.Integrations[i] = r
+		}(i)
+	}
+	wg.Wait()
+
+	return receiver_ops.NewTestReceiverOK().WithPayload(res)
+}
+
 func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Responder {
 	var (
 		receiverFilter *regexp.Regexp
@@ -267,6 +427,11 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 	alertFilter := api.alertFilter(matchers, *params.Silenced, *params.Inhibited, *params.Active)
 	now := time.Now()
 
+	var limited map[prometheus_model.Fingerprint][]string
+	if api.limitedAlerts != nil {
+		limited = api.limitedAlerts()
+	}
+
 	api.mtx.RLock()
 	for a := range alerts.Next() {
 		if err = alerts.Err(); err != nil {
@@ -291,6 +456,7 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 		}
 
 		alert := AlertToOpenAPIAlert(a, api.getAlertStatus(a.Fingerprint()), receivers)
+		alert.Status.LimitedBy = limited[a.Fingerprint()]
 
 		res = append(res, alert)
 	}
@@ -357,8 +523,16 @@ func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.
 		validAlerts = append(validAlerts, a)
 	}
 	if err := api.alerts.Put(validAlerts...); err != nil {
-		level.Error(logger).Log("msg", "Failed to create alerts", "err", err)
-		return alert_ops.NewPostAlertsInternalServerError().WithPayload(err.Error())
+		// Alerts rejected by the provider, for instance because of limits,
+		// are reported like invalid alerts while the others were stored.
+		var rejected *types.MultiError
+		if !errors.As(err, &rejected) {
+			level.Error(logger).Log("msg", "Failed to create alerts", "err", err)
+			return alert_ops.NewPostAlertsInternalServerError().WithPayload(err.Error())
+		}
+		for _, err := range rejected.Errors() {
+			validationErrs.Add(err)
+		}
 	}
 
 	if validationErrs.Len() > 0 {
@@ -369,6 +543,296 @@ func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.
 	return alert_ops.NewPostAlertsOK()
 }
 
+func (api *API) getAlertAckHandler(params alert_ops.GetAlertAckParams) middleware.Responder {
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		return alert_ops.NewGetAlertAckBadRequest().WithPayload(fmt.Sprintf("invalid fingerprint: %v", err))
+	}
+
+	a, err := api.acks.Get(fp)
+	if err != nil {
+		return alert_ops.NewGetAlertAckNotFound()
+	}
+	return alert_ops.NewGetAlertAckOK().WithPayload(AcknowledgementToOpenAPI(a))
+}
+
+func (api *API) postAlertAckHandler(params alert_ops.PostAlertAckParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		return alert_ops.NewPostAlertAckBadRequest().WithPayload(fmt.Sprintf("invalid fingerprint: %v", err))
+	}
+
+	if _, err := api.alerts.Get(fp); err != nil {
+		if errors.Is(err, provider.ErrNotFound) || errors.Is(err, store.ErrNotFound) {
+			return alert_ops.NewPostAlertAckNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to get alert", "err", err)
+		return alert_ops.NewPostAlertAckInternalServerError().WithPayload(err.Error())
+	}
+
+	a, err := api.acks.Acknowledge(
+		fp,
+		*params.Acknowledgement.AcknowledgedBy,
+		params.Acknowledgement.Comment,
+		time.Time(params.Acknowledgement.ExpiresAt),
+	)
+	if err != nil {
+		level.Debug(logger).Log("msg", "Failed to acknowledge alert", "err", err)
+		return alert_ops.NewPostAlertAckBadRequest().WithPayload(err.Error())
+	}
+	return alert_ops.NewPostAlertAckOK().WithPayload(AcknowledgementToOpenAPI(a))
+}
+
+func (api *API) deleteAlertAckHandler(params alert_ops.DeleteAlertAckParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		return alert_ops.NewDeleteAlertAckBadRequest().WithPayload(fmt.Sprintf("invalid fingerprint: %v", err))
+	}
+
+	if err := api.acks.Unacknowledge(fp); err != nil {
+		if errors.Is(err, ack.ErrNotFound) {
+			return alert_ops.NewDeleteAlertAckNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to remove acknowledgement", "err", err)
+		return alert_ops.NewDeleteAlertAckInternalServerError().WithPayload(err.Error())
+	}
+	return alert_ops.NewDeleteAlertAckOK()
+}
+
+func (api *API) getAlertExplanationHandler(params alert_ops.GetAlertExplanationParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	fp, err := prometheus_model.ParseFingerprint(params.Fingerprint)
+	if err != nil {
+		return alert_ops.NewGetAlertExplanationBadRequest().WithPayload(fmt.Sprintf("invalid fingerprint: %v", err))
+	}
+
+	a, err := api.alerts.Get(fp)
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) || errors.Is(err, store.ErrNotFound) {
+			return alert_ops.NewGetAlertExplanationNotFound()
+		}
+		level.Error(logger).Log("msg", "Failed to get alert", "err", err)
+		return alert_ops.NewGetAlertExplanationInternalServerError().WithPayload(err.Error())
+	}
+
+	sils, _, err := api.silences.Query(
+		silence.QState(types.SilenceStateActive, types.SilenceStatePending),
+		silence.QMatches(a.Labels),
+	)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get silences", "err", err)
+		return alert_ops.NewGetAlertExplanationInternalServerError().WithPayload(err.Error())
+	}
+
+	res := &open_api_models.AlertExplanation{
+		InhibitRules: []*open_api_models.InhibitRuleExplanation{},
+		Silences:     []*open_api_models.SilenceExplanation{},
+		Routes:       []*open_api_models.RouteExplanation{},
+	}
+	gettableSils := make(open_api_models.GettableSilences, 0, len(sils))
+	protoSils := make(map[string]*silencepb.Silence, len(sils))
+	for _, ps := range sils {
+		sil, err := GettableSilenceFromProto(ps)
+		if err != nil {
+			level.Error(logger).Log("msg", "Failed to unmarshal silence from proto", "err", err)
+			return alert_ops.NewGetAlertExplanationInternalServerError().WithPayload(err.Error())
+		}
+		gettableSils = append(gettableSils, &sil)
+		protoSils[ps.Id] = ps
+	}
+	SortSilences(gettableSils)
+
+	now := time.Now()
+	api.mtx.RLock()
+	defer api.mtx.RUnlock()
+
+	timeIntervals := map[string][]timeinterval.TimeInterval{}
+	for _, ti := range api.alertmanagerConfig.MuteTimeIntervals {
+		timeIntervals[ti.Name] = ti.TimeIntervals
+	}
+	for _, ti := range api.alertmanagerConfig.TimeIntervals {
+		timeIntervals[ti.Name] = ti.TimeIntervals
+	}
+	intervener := timeinterval.NewIntervener(timeIntervals)
+
+	// Like for the silencer, pending silences and the ones outside of their
+	// time intervals don't mute the alert.
+	for _, sil := range gettableSils {
+		mutes, err := silence.Mutes(protoSils[*sil.ID], intervener, now)
+		if err != nil {
+			level.Debug(logger).Log("msg", "Failed to check the time intervals of silence", "id", *sil.ID, "err", err)
+		}
+		res.Silences = append(res.Silences, &open_api_models.SilenceExplanation{Silence: sil, Mutes: &mutes})
+	}
+
+	explainTimeIntervals := func(names []string) ([]*open_api_models.TimeIntervalExplanation, bool, error) {
+		res := make([]*open_api_models.TimeIntervalExplanation, 0, len(names))
+		containsNow := false
+		for _, name := range names {
+			contains, err := intervener.Mutes([]string{name}, now)
+			if err != nil {
+				return nil, false, err
+			}
+			name := name
+			res = append(res, &open_api_models.TimeIntervalExplanation{Name: &name, Contains: &contains})
+			containsNow = containsNow || contains
+		}
+		return res, containsNow, nil
+	}
+
+	routes := api.route.Match(a.Labels)
+	receivers := make([]string, 0, len(routes))
+	for _, r := range routes {
+		receivers = append(receivers, r.RouteOpts.Receiver)
+
+		mute, inMute, err := explainTimeIntervals(r.RouteOpts.MuteTimeIntervals)
+		if err != nil {
+			return alert_ops.NewGetAlertExplanationInternalServerError().WithPayload(err.Error())
+		}
+		active, inActive, err := explainTimeIntervals(r.RouteOpts.ActiveTimeIntervals)
+		if err != nil {
+			return alert_ops.NewGetAlertExplanationInternalServerError().WithPayload(err.Error())
+		}
+		// Notifications are muted outside of the active time intervals of a
+		// route, if it has any.
+		muted := inMute || (len(active) > 0 && !inActive)
+		receiver := r.RouteOpts.Receiver
+		res.Routes = append(res.Routes, &open_api_models.RouteExplanation{
+			Receiver:            &receiver,
+			MuteTimeIntervals:   mute,
+			ActiveTimeIntervals: active,
+			Muted:               &muted,
+		})
+	}
+	res.Alert = AlertToOpenAPIAlert(a, api.getAlertStatus(fp), receivers)
+
+	if api.inhibitor != nil {
+		for _, e := range api.inhibitor.Explain(a.Labels) {
+			res.InhibitRules = append(res.InhibitRules, InhibitRuleExplanationToOpenAPI(e))
+		}
+	}
+
+	return alert_ops.NewGetAlertExplanationOK().WithPayload(res)
+}
+
+func (api *API) getInhibitionsHandler(params inhibition_ops.GetInhibitionsParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	api.mtx.RLock()
+	defer api.mtx.RUnlock()
+
+	res := open_api_models.Inhibitions{}
+	if api.inhibitor == nil {
+		return inhibition_ops.NewGetInhibitionsOK().WithPayload(res)
+	}
+
+	toOpenAPIAlert := func(a *types.Alert) *open_api_models.GettableAlert {
+		routes := api.route.Match(a.Labels)
+		receivers := make([]string, 0, len(routes))
+		for _, r := range routes {
+			receivers = append(receivers, r.RouteOpts.Receiver)
+		}
+		return AlertToOpenAPIAlert(a, api.getAlertStatus(a.Fingerprint()), receivers)
+	}
+
+	for i, r := range api.inhibitor.Rules() {
+		inhibition := InhibitRuleToOpenAPI(r, i)
+		for _, a := range r.Sources() {
+			inhibition.Sources = append(inhibition.Sources, toOpenAPIAlert(a))
+		}
+		for _, fp := range r.Targets() {
+			a, err := api.alerts.Get(fp)
+			if err != nil {
+				// The alert may have been garbage collected since the
+				// targets were last pruned.
+				if errors.Is(err, provider.ErrNotFound) || errors.Is(err, store.ErrNotFound) {
+					continue
+				}
+				level.Error(logger).Log("msg", "Failed to get alert", "err", err)
+				return inhibition_ops.NewGetInhibitionsInternalServerError().WithPayload(err.Error())
+			}
+			inhibition.Targets = append(inhibition.Targets, toOpenAPIAlert(a))
+		}
+		res = append(res, inhibition)
+	}
+
+	return inhibition_ops.NewGetInhibitionsOK().WithPayload(res)
+}
+
+func (api *API) getNotificationsHandler(params notification_ops.GetNotificationsParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	qparams := []nflog.QueryParam{nflog.QHistory()}
+	if params.Receiver != nil {
+		qparams = append(qparams, nflog.QReceiverName(*params.Receiver))
+	}
+	if params.Integration != nil {
+		qparams = append(qparams, nflog.QIntegration(*params.Integration))
+	}
+	if params.GroupKey != nil {
+		qparams = append(qparams, nflog.QGroupKey(*params.GroupKey))
+	}
+	var since, until time.Time
+	if params.Since != nil {
+		since = time.Time(*params.Since)
+	}
+	if params.Until != nil {
+		until = time.Time(*params.Until)
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return notification_ops.NewGetNotificationsBadRequest().WithPayload("until must not be before since")
+	}
+	qparams = append(qparams, nflog.QTimeRange(since, until))
+
+	entries, err := api.nflog.Query(qparams...)
+	if err != nil && !errors.Is(err, nflog.ErrNotFound) {
+		level.Error(logger).Log("msg", "Failed to query notification log", "err", err)
+		return notification_ops.NewGetNotificationsInternalServerError().WithPayload(err.Error())
+	}
+
+	res := open_api_models.Notifications{}
+	for _, e := range entries {
+		// The escalation state of the aggregation groups is stored in the
+		// notification log as well, it doesn't describe a notification.
+		if e.Receiver.Integration == dispatch.EscalationIntegration {
+			continue
+		}
+		res = append(res, NotificationEntryToOpenAPI(e))
+	}
+	return notification_ops.NewGetNotificationsOK().WithPayload(res)
+}
+
+func (api *API) getDeadLettersHandler(params notification_ops.GetDeadLettersParams) middleware.Responder {
+	res := open_api_models.DeadLetters{}
+	if api.deadLetters != nil {
+		for _, d := range api.deadLetters.List() {
+			res = append(res, DeadLetterToOpenAPI(d))
+		}
+	}
+	return notification_ops.NewGetDeadLettersOK().WithPayload(res)
+}
+
+func (api *API) redriveDeadLetterHandler(params notification_ops.RedriveDeadLetterParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	if api.deadLetters == nil {
+		return notification_ops.NewRedriveDeadLetterNotFound()
+	}
+	if err := api.deadLetters.Redrive(params.HTTPRequest.Context(), params.ID); err != nil {
+		if errors.Is(err, notify.ErrDeadLetterNotFound) {
+			return notification_ops.NewRedriveDeadLetterNotFound()
+		}
+		level.Debug(logger).Log("msg", "Failed to re-drive notification", "id", params.ID, "err", err)
+		return notification_ops.NewRedriveDeadLetterInternalServerError().WithPayload(err.Error())
+	}
+	return notification_ops.NewRedriveDeadLetterOK()
+}
+
 func (api *API) getAlertGroupsHandler(params alertgroup_ops.GetAlertGroupsParams) middleware.Responder {
 	logger := api.requestLogger(params.HTTPRequest)
 
@@ -623,11 +1087,38 @@ func (api *API) getSilenceHandler(params silence_ops.GetSilenceParams) middlewar
 	return silence_ops.NewGetSilenceOK().WithPayload(&sil)
 }
 
+func (api *API) getSilenceHistoryHandler(params silence_ops.GetSilenceHistoryParams) middleware.Responder {
+	logger := api.requestLogger(params.HTTPRequest)
+
+	sils, _, err := api.silences.Query(silence.QIDs(params.SilenceID.String()))
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get silence by id", "err", err, "id", params.SilenceID.String())
+		return silence_ops.NewGetSilenceHistoryInternalServerError().WithPayload(err.Error())
+	}
+
+	if len(sils) == 0 {
+		level.Error(logger).Log("msg", "Failed to find silence", "err", err, "id", params.SilenceID.String())
+		return silence_ops.NewGetSilenceHistoryNotFound()
+	}
+
+	revisions, err := SilenceRevisionsFromProto(sils[0])
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to convert silence revisions from proto", "err", err)
+		return silence_ops.NewGetSilenceHistoryInternalServerError().WithPayload(err.Error())
+	}
+
+	return silence_ops.NewGetSilenceHistoryOK().WithPayload(revisions)
+}
+
 func (api *API) deleteSilenceHandler(params silence_ops.DeleteSilenceParams) middleware.Responder {
 	logger := api.requestLogger(params.HTTPRequest)
 
 	sid := params.SilenceID.String()
-	if err := api.silences.Expire(sid); err != nil {
+	var author string
+	if params.Author != nil {
+		author = *params.Author
+	}
+	if err := api.silences.ExpireWithAuthor(sid, author); err != nil {
 		level.Error(logger).Log("msg", "Failed to expire silence", "err", err)
 		if errors.Is(err, silence.ErrNotFound) {
 			return silence_ops.NewDeleteSilenceNotFound()
@@ -660,6 +1151,18 @@ func (api *API) postSilencesHandler(params silence_ops.PostSilencesParams) middl
 		return silence_ops.NewPostSilencesBadRequest().WithPayload(msg)
 	}
 
+	if name, ok := api.unknownTimeInterval(sil.TimeIntervals); !ok {
+		msg := fmt.Sprintf("Failed to create silence: time interval %q doesn't exist", name)
+		level.Error(logger).Log("msg", msg)
+		return silence_ops.NewPostSilencesBadRequest().WithPayload(msg)
+	}
+
+	if err := api.checkSilencePolicy(sil, time.Now()); err != nil {
+		msg := fmt.Sprintf("Failed to create silence: silence policy violated: %v", err)
+		level.Error(logger).Log("msg", msg)
+		return silence_ops.NewPostSilencesBadRequest().WithPayload(msg)
+	}
+
 	sid, err := api.silences.Set(sil)
 	if err != nil {
 		level.Error(logger).Log("msg", "Failed to create silence", "err", err)
@@ -674,6 +1177,117 @@ func (api *API) postSilencesHandler(params silence_ops.PostSilencesParams) middl
 	})
 }
 
+// previewSilenceHandler returns the current alerts and aggregation groups the
+// silence would mute without storing it. The time range and time intervals of
+// the silence are ignored.
+func (api *API) previewSilenceHandler(params silence_ops.PreviewSilenceParams) middleware.Responder {
+	var (
+		ctx    = params.HTTPRequest.Context()
+		logger = api.requestLogger(params.HTTPRequest)
+	)
+
+	sil, err := PostableSilenceToProto(params.Silence)
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to marshal silence to proto", "err", err)
+		return silence_ops.NewPreviewSilenceBadRequest().WithPayload(
+			fmt.Sprintf("failed to convert API silence to internal silence: %v", err.Error()),
+		)
+	}
+	matchers, err := silence.Matchers(sil)
+	if err != nil {
+		level.Debug(logger).Log("msg", "Invalid silence matchers", "err", err)
+		return silence_ops.NewPreviewSilenceBadRequest().WithPayload(err.Error())
+	}
+
+	af := func(a *types.Alert, now time.Time) bool {
+		if !a.EndsAt.IsZero() && a.EndsAt.Before(now) {
+			return false
+		}
+		return matchers.Matches(a.Labels)
+	}
+
+	res := &open_api_models.SilencePreview{
+		Alerts: open_api_models.GettableAlerts{},
+		Groups: open_api_models.AlertGroups{},
+	}
+
+	alerts := api.alerts.GetPending()
+	defer alerts.Close()
+
+	now := time.Now()
+	api.mtx.RLock()
+	for a := range alerts.Next() {
+		if err = alerts.Err(); err != nil {
+			break
+		}
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if !af(a, now) {
+			continue
+		}
+
+		routes := api.route.Match(a.Labels)
+		receivers := make([]string, 0, len(routes))
+		for _, r := range routes {
+			receivers = append(receivers, r.RouteOpts.Receiver)
+		}
+		res.Alerts = append(res.Alerts, AlertToOpenAPIAlert(a, api.getAlertStatus(a.Fingerprint()), receivers))
+	}
+	api.mtx.RUnlock()
+
+	if err != nil {
+		level.Error(logger).Log("msg", "Failed to get alerts", "err", err)
+		return silence_ops.NewPreviewSilenceInternalServerError().WithPayload(err.Error())
+	}
+	sort.Slice(res.Alerts, func(i, j int) bool {
+		return *res.Alerts[i].Fingerprint < *res.Alerts[j].Fingerprint
+	})
+
+	alertGroups, allReceivers := api.alertGroups(func(*dispatch.Route) bool { return true }, af)
+	for _, alertGroup := range alertGroups {
+		ag := &open_api_models.AlertGroup{
+			Receiver: &open_api_models.Receiver{Name: &alertGroup.Receiver},
+			Labels:   ModelLabelSetToAPILabelSet(alertGroup.Labels),
+			Alerts:   make([]*open_api_models.GettableAlert, 0, len(alertGroup.Alerts)),
+		}
+		for _, alert := range alertGroup.Alerts {
+			fp := alert.Fingerprint()
+			ag.Alerts = append(ag.Alerts, AlertToOpenAPIAlert(alert, api.getAlertStatus(fp), allReceivers[fp]))
+		}
+		res.Groups = append(res.Groups, ag)
+	}
+
+	return silence_ops.NewPreviewSilenceOK().WithPayload(res)
+}
+
+// unknownTimeInterval returns the first of the names which isn't a time
+// interval of the configuration and false, true if all of them exist.
+func (api *API) unknownTimeInterval(names []string) (string, bool) {
+	if len(names) == 0 {
+		return "", true
+	}
+
+	api.mtx.RLock()
+	defer api.mtx.RUnlock()
+
+	known := map[string]struct{}{}
+	if api.alertmanagerConfig != nil {
+		for _, ti := range api.alertmanagerConfig.MuteTimeIntervals {
+			known[ti.Name] = struct{}{}
+		}
+		for _, ti := range api.alertmanagerConfig.TimeIntervals {
+			known[ti.Name] = struct{}{}
+		}
+	}
+	for _, name := range names {
+		if _, ok := known[name]; !ok {
+			return name, false
+		}
+	}
+	return "", true
+}
+
 func parseFilter(filter []string) ([]*labels.Matcher, error) {
 	matchers := make([]*labels.Matcher, 0, len(filter))
 	for _, matcherString := range filter {
@@ -14,28 +14,49 @@
 package v2
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
+	"github.com/prometheus/alertmanager/ack"
+	"github.com/prometheus/alertmanager/api/metrics"
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	alert_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
 	general_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
+	inhibition_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/inhibition"
+	notification_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/notification"
 	receiver_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/events"
+	"github.com/prometheus/alertmanager/inhibit"
+	"github.com/prometheus/alertmanager/nflog"
+	"github.com/prometheus/alertmanager/nflog/nflogpb"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/provider/mem"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 
 	"github.com/go-kit/log"
@@ -211,6 +232,92 @@ func TestDeleteSilenceHandler(t *testing.T) {
 	}
 }
 
+func TestGetSilenceHistoryHandler(t *testing.T) {
+	now := time.Now()
+	silences := newSilences(t)
+
+	newSilence := func(id, author, pattern string, endsAt time.Time) *silencepb.Silence {
+		return &silencepb.Silence{
+			Id:        id,
+			Matchers:  []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "a", Pattern: pattern}},
+			StartsAt:  now,
+			EndsAt:    endsAt,
+			CreatedBy: author,
+			Comment:   "maintenance",
+		}
+	}
+	sid, err := silences.Set(newSilence("", "alice", "b", now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	// Extend the silence, then change its matchers which replaces it.
+	_, err = silences.Set(newSilence(sid, "bob", "b", now.Add(2*time.Hour)))
+	require.NoError(t, err)
+	newSid, err := silences.Set(newSilence(sid, "bob", "c", now.Add(2*time.Hour)))
+	require.NoError(t, err)
+	require.NotEqual(t, sid, newSid)
+
+	api := API{
+		uptime:   time.Now(),
+		silences: silences,
+		logger:   log.NewNopLogger(),
+	}
+
+	get := func(sid string) (int, []byte) {
+		r, err := http.NewRequest("GET", "/api/v2/silence/"+sid+"/history", nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		api.getSilenceHistoryHandler(silence_ops.GetSilenceHistoryParams{
+			HTTPRequest: r,
+			SilenceID:   strfmt.UUID(sid),
+		}).WriteResponse(w, runtime.JSONProducer())
+		body, _ := io.ReadAll(w.Result().Body)
+		return w.Code, body
+	}
+
+	code, body := get(newSid)
+	require.Equal(t, http.StatusOK, code, string(body))
+	var revisions open_api_models.SilenceRevisions
+	require.NoError(t, json.Unmarshal(body, &revisions))
+
+	type revision struct{ action, silenceID, author, pattern string }
+	var got []revision
+	for _, r := range revisions {
+		got = append(got, revision{*r.Action, *r.SilenceID, r.Author, *r.Matchers[0].Value})
+	}
+	require.Equal(t, []revision{
+		{"create", sid, "alice", "b"},
+		{"update", sid, "bob", "b"},
+		{"expire", sid, "bob", "b"},
+		{"create", newSid, "bob", "c"},
+	}, got)
+	require.Equal(t, "maintenance", revisions[0].Comment)
+	require.Equal(t, strfmt.DateTime(now.Add(2*time.Hour)).String(), revisions[1].EndsAt.String())
+
+	// The author of the expiration is taken from the request.
+	r, err := http.NewRequest("DELETE", "/api/v2/silence/"+newSid+"?author=carol", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	author := "carol"
+	api.deleteSilenceHandler(silence_ops.DeleteSilenceParams{
+		HTTPRequest: r,
+		SilenceID:   strfmt.UUID(newSid),
+		Author:      &author,
+	}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+
+	code, body = get(newSid)
+	require.Equal(t, http.StatusOK, code, string(body))
+	revisions = nil
+	require.NoError(t, json.Unmarshal(body, &revisions))
+	require.Len(t, revisions, 5)
+	require.Equal(t, "expire", *revisions[4].Action)
+	require.Equal(t, newSid, *revisions[4].SilenceID)
+	require.Equal(t, "carol", revisions[4].Author)
+
+	code, _ = get("unknown")
+	require.Equal(t, http.StatusNotFound, code)
+}
+
 func TestPostSilencesHandler(t *testing.T) {
 	now := time.Now()
 	silences := newSilences(t)
@@ -297,6 +404,368 @@ func TestPostSilencesHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Silences with time intervals", func(t *testing.T) {
+		api := API{
+			uptime:   time.Now(),
+			silences: silences,
+			logger:   log.NewNopLogger(),
+			alertmanagerConfig: &config.Config{
+				MuteTimeIntervals: []config.MuteTimeInterval{{Name: "weekends"}},
+				TimeIntervals:     []config.TimeInterval{{Name: "business-hours"}},
+			},
+		}
+
+		for _, tc := range []struct {
+			timeIntervals []string
+			expectedCode  int
+		}{
+			{[]string{"weekends", "business-hours"}, 200},
+			{[]string{"weekends", "unknown"}, 400},
+		} {
+			silence, silenceBytes := createSilence(t, "", "silenceCreator", now.Add(time.Hour), now.Add(2*time.Hour))
+			silence.TimeIntervals = tc.timeIntervals
+
+			r, err := http.NewRequest("POST", "/api/v2/silences", bytes.NewReader(silenceBytes))
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			responder := api.postSilencesHandler(silence_ops.PostSilencesParams{
+				HTTPRequest: r,
+				Silence:     &silence,
+			})
+			responder.WriteResponse(w, runtime.TextProducer())
+			body, _ := io.ReadAll(w.Result().Body)
+
+			require.Equal(t, tc.expectedCode, w.Code, string(body))
+			if tc.expectedCode == 400 {
+				require.Equal(t, `Failed to create silence: time interval "unknown" doesn't exist`, string(body))
+			}
+		}
+	})
+}
+
+func TestPreviewSilenceHandler(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	cfg, err := config.Load("route:\n  receiver: team-X\nreceivers:\n- name: team-X\n")
+	require.NoError(t, err)
+
+	now := time.Now()
+	var all []*types.Alert
+	for _, lset := range []model.LabelSet{
+		{"alertname": "a1", "team": "x"},
+		{"alertname": "a2", "team": "y"},
+		{"alertname": "a3", "team": "x"},
+	} {
+		a := &types.Alert{
+			Alert: model.Alert{
+				Labels:   lset,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			UpdatedAt: now,
+		}
+		require.NoError(t, alerts.Put(a))
+		all = append(all, a)
+	}
+	// Resolved alerts aren't muted.
+	all[2].EndsAt = now.Add(-time.Minute)
+	require.NoError(t, alerts.Put(all[2]))
+	marker.SetActiveOrSilenced(all[0].Fingerprint(), 0, nil, nil)
+
+	silences := newSilences(t)
+	api := API{
+		uptime:         time.Now(),
+		alerts:         alerts,
+		silences:       silences,
+		getAlertStatus: marker.Status,
+		route:          dispatch.NewRoute(cfg.Route, nil),
+		logger:         log.NewNopLogger(),
+		alertGroups: func(_ func(*dispatch.Route) bool, af func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string) {
+			ag := &dispatch.AlertGroup{Labels: model.LabelSet{}, Receiver: "team-X"}
+			for _, a := range all {
+				if af(a, time.Now()) {
+					ag.Alerts = append(ag.Alerts, a)
+				}
+			}
+			return dispatch.AlertGroups{ag}, nil
+		},
+	}
+
+	preview := func(name, value string) (int, []byte) {
+		sil, _ := createSilence(t, "", "silenceCreator", now, now.Add(time.Hour))
+		sil.Matchers[0].Name = &name
+		sil.Matchers[0].Value = &value
+
+		r, err := http.NewRequest("POST", "/api/v2/silences/preview", nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		api.previewSilenceHandler(silence_ops.PreviewSilenceParams{
+			HTTPRequest: r,
+			Silence:     &sil,
+		}).WriteResponse(w, runtime.JSONProducer())
+		body, _ := io.ReadAll(w.Result().Body)
+		return w.Code, body
+	}
+
+	code, body := preview("team", "x")
+	require.Equal(t, http.StatusOK, code, string(body))
+	var res open_api_models.SilencePreview
+	require.NoError(t, json.Unmarshal(body, &res))
+	require.Len(t, res.Alerts, 1)
+	require.Equal(t, "a1", res.Alerts[0].Labels["alertname"])
+	require.Len(t, res.Alerts[0].Receivers, 1)
+	require.Equal(t, "team-X", *res.Alerts[0].Receivers[0].Name)
+	require.Equal(t, open_api_models.AlertStatusStateActive, *res.Alerts[0].Status.State)
+	require.Len(t, res.Groups, 1)
+	require.Len(t, res.Groups[0].Alerts, 1)
+
+	// Nothing is stored.
+	sils, _, err := silences.Query()
+	require.NoError(t, err)
+	require.Empty(t, sils)
+
+	code, body = preview("team", "")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, string(body), "at least one matcher must not match the empty string")
+}
+
+func TestGetAlertExplanationHandler(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+  routes:
+  - receiver: team-Y
+    matchers: [team="y"]
+    mute_time_intervals: [always]
+    active_time_intervals: [never]
+receivers:
+- name: team-X
+- name: team-Y
+inhibit_rules:
+- source_matchers: [severity="critical"]
+  target_matchers: [severity="warning"]
+  equal: [team]
+time_intervals:
+- name: always
+  time_intervals:
+  - weekdays: ['sunday:saturday']
+- name: never
+  time_intervals:
+  - years: ['2000']
+`)
+	require.NoError(t, err)
+
+	now := time.Now()
+	newAlert := func(lset model.LabelSet) *types.Alert {
+		a := &types.Alert{
+			Alert: model.Alert{
+				Labels:   lset,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			UpdatedAt: now,
+		}
+		require.NoError(t, alerts.Put(a))
+		return a
+	}
+	target := newAlert(model.LabelSet{"alertname": "a", "team": "y", "severity": "warning"})
+	source := newAlert(model.LabelSet{"alertname": "b", "team": "y", "severity": "critical"})
+
+	inhibitor := inhibit.NewInhibitor(alerts, cfg.InhibitRules, marker, log.NewNopLogger(), nil)
+	go inhibitor.Run()
+	defer inhibitor.Stop()
+	require.Eventually(t, func() bool {
+		return inhibitor.Explain(target.Labels)[0].Inhibited
+	}, 5*time.Second, 10*time.Millisecond)
+
+	silences := newSilences(t)
+	for _, sil := range []*silencepb.Silence{
+		{Comment: "active", StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+		{Comment: "pending", StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)},
+		{Comment: "in time intervals", StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour), TimeIntervals: []string{"always"}},
+		{Comment: "outside time intervals", StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour), TimeIntervals: []string{"never"}},
+	} {
+		sil.Matchers = []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "alertname", Pattern: "a"}}
+		_, err = silences.Set(sil)
+		require.NoError(t, err)
+	}
+	_, err = silences.Set(&silencepb.Silence{
+		Matchers: []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "alertname", Pattern: "b"}},
+		StartsAt: now.Add(-time.Minute),
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	api := API{
+		uptime:         time.Now(),
+		alerts:         alerts,
+		silences:       silences,
+		getAlertStatus: marker.Status,
+		logger:         log.NewNopLogger(),
+	}
+	api.Update(cfg, nil, nil, inhibitor)
+
+	explain := func(fp string) (int, []byte) {
+		r, err := http.NewRequest("GET", "/api/v2/alerts/"+fp+"/explain", nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		api.getAlertExplanationHandler(alert_ops.GetAlertExplanationParams{
+			HTTPRequest: r,
+			Fingerprint: fp,
+		}).WriteResponse(w, runtime.JSONProducer())
+		body, _ := io.ReadAll(w.Result().Body)
+		return w.Code, body
+	}
+
+	code, body := explain(target.Fingerprint().String())
+	require.Equal(t, http.StatusOK, code, string(body))
+	var res open_api_models.AlertExplanation
+	require.NoError(t, json.Unmarshal(body, &res))
+
+	require.Equal(t, target.Fingerprint().String(), *res.Alert.Fingerprint)
+
+	require.Len(t, res.InhibitRules, 1)
+	rule := res.InhibitRules[0]
+	require.Equal(t, []string{`severity="critical"`}, rule.SourceMatchers)
+	require.Equal(t, []string{`severity="warning"`}, rule.TargetMatchers)
+	require.Equal(t, []string{"team"}, rule.Equal)
+	require.True(t, *rule.TargetMatched)
+	require.True(t, *rule.Inhibited)
+	require.Equal(t, source.Fingerprint().String(), rule.InhibitedBy)
+	require.Len(t, rule.Sources, 1)
+	require.True(t, *rule.Sources[0].Inhibits)
+	require.Empty(t, rule.Sources[0].UnequalLabels)
+
+	// Only the active silences in their time intervals mute the alert.
+	mutes := map[string]bool{}
+	for _, sil := range res.Silences {
+		require.Equal(t, "a", *sil.Silence.Matchers[0].Value)
+		mutes[*sil.Silence.Comment] = *sil.Mutes
+	}
+	require.Equal(t, map[string]bool{
+		"active":                 true,
+		"pending":                false,
+		"in time intervals":      true,
+		"outside time intervals": false,
+	}, mutes)
+
+	require.Len(t, res.Routes, 1)
+	route := res.Routes[0]
+	require.Equal(t, "team-Y", *route.Receiver)
+	require.Len(t, route.MuteTimeIntervals, 1)
+	require.Equal(t, "always", *route.MuteTimeIntervals[0].Name)
+	require.True(t, *route.MuteTimeIntervals[0].Contains)
+	require.Len(t, route.ActiveTimeIntervals, 1)
+	require.Equal(t, "never", *route.ActiveTimeIntervals[0].Name)
+	require.False(t, *route.ActiveTimeIntervals[0].Contains)
+	require.True(t, *route.Muted)
+
+	// The source alert isn't a target of the rule.
+	code, body = explain(source.Fingerprint().String())
+	require.Equal(t, http.StatusOK, code, string(body))
+	require.NoError(t, json.Unmarshal(body, &res))
+	require.False(t, *res.InhibitRules[0].TargetMatched)
+	require.False(t, *res.InhibitRules[0].Inhibited)
+	require.Empty(t, res.InhibitRules[0].Sources)
+
+	code, _ = explain("foo")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = explain(model.LabelSet{"alertname": "c"}.Fingerprint().String())
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestGetInhibitionsHandler(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+inhibit_rules:
+- name: datacenter-down
+  source_matchers: [alertname="DatacenterDown"]
+  target_matchers: [severity="warning"]
+  equal: [dc]
+- source_matchers: [alertname="ClusterDown"]
+  target_matchers: [severity="warning"]
+`)
+	require.NoError(t, err)
+
+	now := time.Now()
+	newAlert := func(lset model.LabelSet) *types.Alert {
+		a := &types.Alert{
+			Alert: model.Alert{
+				Labels:   lset,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			UpdatedAt: now,
+		}
+		require.NoError(t, alerts.Put(a))
+		return a
+	}
+	source := newAlert(model.LabelSet{"alertname": "DatacenterDown", "dc": "a"})
+	target := newAlert(model.LabelSet{"alertname": "HighLatency", "severity": "warning", "dc": "a"})
+	newAlert(model.LabelSet{"alertname": "HighLatency", "severity": "warning", "dc": "b"})
+
+	inhibitor := inhibit.NewInhibitor(alerts, cfg.InhibitRules, marker, log.NewNopLogger(), nil)
+	go inhibitor.Run()
+	defer inhibitor.Stop()
+	require.Eventually(t, func() bool {
+		return len(inhibitor.Rules()[0].Sources()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, inhibitor.Mutes(target.Labels))
+
+	api := API{
+		uptime:         time.Now(),
+		alerts:         alerts,
+		getAlertStatus: marker.Status,
+		logger:         log.NewNopLogger(),
+	}
+	api.Update(cfg, nil, nil, inhibitor)
+
+	r, err := http.NewRequest("GET", "/api/v2/inhibitions", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.getInhibitionsHandler(inhibition_ops.GetInhibitionsParams{
+		HTTPRequest: r,
+	}).WriteResponse(w, runtime.JSONProducer())
+	body, _ := io.ReadAll(w.Result().Body)
+	require.Equal(t, http.StatusOK, w.Code, string(body))
+
+	var res open_api_models.Inhibitions
+	require.NoError(t, json.Unmarshal(body, &res))
+	require.Len(t, res, 2)
+
+	require.Equal(t, int64(0), *res[0].Index)
+	require.Equal(t, "datacenter-down", res[0].Name)
+	require.Equal(t, []string{`alertname="DatacenterDown"`}, res[0].SourceMatchers)
+	require.Equal(t, []string{`severity="warning"`}, res[0].TargetMatchers)
+	require.Equal(t, []string{"dc"}, res[0].Equal)
+	require.Len(t, res[0].Sources, 1)
+	require.Equal(t, source.Fingerprint().String(), *res[0].Sources[0].Fingerprint)
+	require.Len(t, res[0].Targets, 1)
+	require.Equal(t, target.Fingerprint().String(), *res[0].Targets[0].Fingerprint)
+	require.Equal(t, "team-X", *res[0].Targets[0].Receivers[0].Name)
+
+	require.Equal(t, int64(1), *res[1].Index)
+	require.Empty(t, res[1].Name)
+	require.Empty(t, res[1].Sources)
+	require.Empty(t, res[1].Targets)
 }
 
 func TestCheckSilenceMatchesFilterLabels(t *testing.T) {
@@ -510,3 +979,453 @@ receivers:
 		require.Equal(t, tc.body, string(body))
 	}
 }
+
+func TestPostAlertsHandlerWithLimits(t *testing.T) {
+	cfg, err := config.Load("route:\n  receiver: team-X\nreceivers:\n- name: team-X\n")
+	require.NoError(t, err)
+
+	cb := mem.NewLimitsCallback(mem.Limits{Label: "tenant", MaxAlertsPerLabelValue: 1}, nil)
+	alerts, err := mem.NewAlerts(context.Background(), types.NewMarker(prometheus.NewRegistry()), time.Hour, cb, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	api := API{
+		uptime:             time.Now(),
+		alerts:             alerts,
+		alertmanagerConfig: cfg,
+		logger:             log.NewNopLogger(),
+		m:                  metrics.NewAlerts(nil),
+	}
+
+	newAlert := func(name, tenant string) *open_api_models.PostableAlert {
+		return &open_api_models.PostableAlert{
+			Alert: open_api_models.Alert{
+				Labels: open_api_models.LabelSet{"alertname": name, "tenant": tenant},
+			},
+		}
+	}
+
+	r, err := http.NewRequest("POST", "/api/v2/alerts", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	responder := api.postAlertsHandler(alert_ops.PostAlertsParams{
+		HTTPRequest: r,
+		Alerts: open_api_models.PostableAlerts{
+			newAlert("a1", "t1"),
+			newAlert("a2", "t1"),
+			newAlert("a3", "t2"),
+		},
+	})
+	responder.WriteResponse(w, runtime.TextProducer())
+	body, _ := io.ReadAll(w.Result().Body)
+
+	// The alert exceeding the limit is reported while the others are stored.
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, string(body), `the maximum number of alerts (1) for tenant="t1" is reached`)
+
+	var stored []string
+	it := alerts.GetPending()
+	defer it.Close()
+	for a := range it.Next() {
+		stored = append(stored, a.Name())
+	}
+	require.ElementsMatch(t, []string{"a1", "a3"}, stored)
+}
+
+func TestAlertAckHandlers(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	acks, err := ack.New(ack.Options{Retention: time.Hour, Marker: marker})
+	require.NoError(t, err)
+
+	now := time.Now()
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "a1"},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+	require.NoError(t, alerts.Put(a))
+	fp := a.Fingerprint().String()
+
+	api := API{
+		uptime: time.Now(),
+		alerts: alerts,
+		acks:   acks,
+		logger: log.NewNopLogger(),
+	}
+
+	r, err := http.NewRequest("POST", "/api/v2/alerts/"+fp+"/ack", nil)
+	require.NoError(t, err)
+
+	post := func(fp, by string, expiresAt time.Time) (int, string) {
+		w := httptest.NewRecorder()
+		api.postAlertAckHandler(alert_ops.PostAlertAckParams{
+			HTTPRequest: r,
+			Fingerprint: fp,
+			Acknowledgement: &open_api_models.PostableAcknowledgement{
+				AcknowledgedBy: &by,
+				Comment:        "looking into it",
+				ExpiresAt:      strfmt.DateTime(expiresAt),
+			},
+		}).WriteResponse(w, runtime.JSONProducer())
+		body, _ := io.ReadAll(w.Result().Body)
+		return w.Code, string(body)
+	}
+
+	code, _ := post("invalid", "me", time.Time{})
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = post(model.Fingerprint(1).String(), "me", time.Time{})
+	require.Equal(t, http.StatusNotFound, code)
+	code, body := post(fp, "me", now.Add(-time.Minute))
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, body, "in the past")
+
+	code, body = post(fp, "me", time.Time{})
+	require.Equal(t, http.StatusOK, code, body)
+	require.Contains(t, body, `"acknowledgedBy":"me"`)
+
+	// The acknowledgement is reported in the alert status.
+	status := marker.Status(a.Fingerprint())
+	require.NotNil(t, status.Acknowledgement)
+	require.Equal(t, "looking into it", status.Acknowledgement.Comment)
+
+	w := httptest.NewRecorder()
+	api.getAlertAckHandler(alert_ops.GetAlertAckParams{HTTPRequest: r, Fingerprint: fp}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	api.deleteAlertAckHandler(alert_ops.DeleteAlertAckParams{HTTPRequest: r, Fingerprint: fp}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+	_, acked := marker.Acknowledged(a.Fingerprint())
+	require.False(t, acked)
+
+	w = httptest.NewRecorder()
+	api.deleteAlertAckHandler(alert_ops.DeleteAlertAckParams{HTTPRequest: r, Fingerprint: fp}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	api.getAlertAckHandler(alert_ops.GetAlertAckParams{HTTPRequest: r, Fingerprint: fp}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetNotificationsHandler(t *testing.T) {
+	nl, err := nflog.New(nflog.Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	email := &nflogpb.Receiver{GroupName: "team-a", Integration: "email"}
+	webhook := &nflogpb.Receiver{GroupName: "team-b", Integration: "webhook", Idx: 1}
+	require.NoError(t, nl.Log(email, "{}:{a=\"1\"}", []uint64{1}, nil, 0))
+	require.NoError(t, nl.Log(webhook, "{}:{a=\"2\"}", nil, []uint64{255}, 0))
+	// The previous notifications of a group are returned as well.
+	require.NoError(t, nl.Log(email, "{}:{a=\"1\"}", nil, []uint64{1}, 0))
+	escalation := &nflogpb.Receiver{GroupName: "team-a", Integration: dispatch.EscalationIntegration}
+	require.NoError(t, nl.Log(escalation, "{}:{a=\"1\"}", []uint64{1}, nil, 0))
+
+	api := API{
+		uptime: time.Now(),
+		nflog:  nl,
+		logger: log.NewNopLogger(),
+	}
+
+	str := func(s string) *string { return &s }
+	for _, tc := range []struct {
+		name     string
+		params   notification_ops.GetNotificationsParams
+		code     int
+		expected []string
+	}{
+		{
+			name:     "all",
+			code:     http.StatusOK,
+			expected: []string{"team-a/email", "team-a/email", "team-b/webhook"},
+		},
+		{
+			name:     "receiver",
+			params:   notification_ops.GetNotificationsParams{Receiver: str("team-b")},
+			code:     http.StatusOK,
+			expected: []string{"team-b/webhook"},
+		},
+		{
+			name:     "group key",
+			params:   notification_ops.GetNotificationsParams{GroupKey: str("{}:{a=\"1\"}")},
+			code:     http.StatusOK,
+			expected: []string{"team-a/email", "team-a/email"},
+		},
+		{
+			name:     "no match",
+			params:   notification_ops.GetNotificationsParams{Integration: str("slack")},
+			code:     http.StatusOK,
+			expected: []string{},
+		},
+		{
+			name: "invalid time range",
+			params: notification_ops.GetNotificationsParams{
+				Since: func() *strfmt.DateTime { d := strfmt.DateTime(time.Now()); return &d }(),
+				Until: func() *strfmt.DateTime { d := strfmt.DateTime(time.Now().Add(-time.Hour)); return &d }(),
+			},
+			code: http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := http.NewRequest("GET", "/api/v2/notifications", nil)
+			require.NoError(t, err)
+			tc.params.HTTPRequest = r
+
+			w := httptest.NewRecorder()
+			api.getNotificationsHandler(tc.params).WriteResponse(w, runtime.JSONProducer())
+			require.Equal(t, tc.code, w.Code)
+			if tc.code != http.StatusOK {
+				return
+			}
+
+			var res open_api_models.Notifications
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+			got := make([]string, 0, len(res))
+			for _, n := range res {
+				got = append(got, *n.Receiver+"/"+*n.Integration)
+			}
+			require.ElementsMatch(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDeadLetterHandlers(t *testing.T) {
+	dl, err := notify.NewDeadLetterStore("", 10, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, dl.Add(&notify.DeadLetter{
+		Receiver:    "team-X",
+		Integration: "webhook",
+		GroupKey:    "{}:{}",
+		Reason:      "serverError",
+		Error:       "unavailable",
+	}))
+
+	api := API{
+		uptime:      time.Now(),
+		deadLetters: dl,
+		logger:      log.NewNopLogger(),
+	}
+
+	r, err := http.NewRequest("GET", "/api/v2/notifications/dead-letters", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.getDeadLettersHandler(notification_ops.GetDeadLettersParams{HTTPRequest: r}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res open_api_models.DeadLetters
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Len(t, res, 1)
+	require.Equal(t, "webhook", *res[0].Integration)
+	require.Equal(t, "unavailable", *res[0].Error)
+
+	w = httptest.NewRecorder()
+	api.redriveDeadLetterHandler(notification_ops.RedriveDeadLetterParams{HTTPRequest: r, ID: "unknown"}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// The receiver isn't configured.
+	w = httptest.NewRecorder()
+	api.redriveDeadLetterHandler(notification_ops.RedriveDeadLetterParams{HTTPRequest: r, ID: *res[0].ID}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestGetReceiversHandlerCircuitBreakers(t *testing.T) {
+	cfg, _ := config.Load(`
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+- name: 'team-Y'
+`)
+	cb, err := notify.NewCircuitBreakers(1, time.Minute, nil)
+	require.NoError(t, err)
+	integration := notify.NewIntegration(nil, nil, "webhook", 0, "team-X")
+	b := cb.Get("team-X", &integration)
+	require.True(t, b.Allow())
+	b.Done(errors.New("unavailable"), true)
+
+	api := API{
+		uptime:             time.Now(),
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+		breakers:           cb,
+	}
+
+	r, err := http.NewRequest("GET", "/api/v2/receivers", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.getReceiversHandler(receiver_ops.GetReceiversParams{HTTPRequest: r}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res []*open_api_models.Receiver
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Len(t, res, 2)
+	require.Len(t, res[0].Integrations, 1)
+	require.Equal(t, "webhook", *res[0].Integrations[0].Name)
+	require.Equal(t, "open", *res[0].Integrations[0].CircuitBreaker.State)
+	require.Equal(t, int64(1), *res[0].Integrations[0].CircuitBreaker.ConsecutiveFailures)
+	require.False(t, time.Time(res[0].Integrations[0].CircuitBreaker.OpenedAt).IsZero())
+	require.Empty(t, res[1].Integrations)
+}
+
+func TestTestReceiverHandler(t *testing.T) {
+	var received []string
+	var mtx sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg struct {
+			Receiver    string            `json:"receiver"`
+			GroupLabels map[string]string `json:"groupLabels"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		mtx.Lock()
+		received = append(received, msg.Receiver+" "+msg.GroupLabels["alertname"])
+		mtx.Unlock()
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	cfg, err := config.Load(fmt.Sprintf(`
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+  webhook_configs:
+  - url: %[1]s/ok
+  - url: %[1]s/fail
+`, srv.URL))
+	require.NoError(t, err)
+	tmpl, err := template.FromGlobs(nil)
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://alertmanager.example.com")
+
+	api := API{
+		uptime:             time.Now(),
+		logger:             log.NewNopLogger(),
+		alertmanagerConfig: cfg,
+		tmpl:               tmpl,
+	}
+
+	r, err := http.NewRequest("POST", "/api/v2/receivers/team-X/test", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testReceiverHandler(receiver_ops.TestReceiverParams{HTTPRequest: r, Name: "unknown"}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	api.testReceiverHandler(receiver_ops.TestReceiverParams{
+		HTTPRequest: r,
+		Name:        "team-X",
+		Alerts: open_api_models.PostableAlerts{
+			{Alert: open_api_models.Alert{Labels: open_api_models.LabelSet{}}},
+		},
+	}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Empty(t, received)
+
+	// The default test alert is sent to each integration.
+	w = httptest.NewRecorder()
+	api.testReceiverHandler(receiver_ops.TestReceiverParams{HTTPRequest: r, Name: "team-X"}).WriteResponse(w, runtime.JSONProducer())
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res open_api_models.ReceiverTestResult
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, "team-X", *res.Receiver)
+	require.Len(t, res.Integrations, 2)
+	require.Equal(t, "webhook", *res.Integrations[0].Name)
+	require.True(t, *res.Integrations[0].Success)
+	require.Empty(t, res.Integrations[0].Error)
+	require.Equal(t, int64(1), *res.Integrations[1].Index)
+	require.False(t, *res.Integrations[1].Success)
+	require.Contains(t, res.Integrations[1].Error, "unexpected status code 400")
+	require.Equal(t, []string{"team-X TestAlert", "team-X TestAlert"}, received)
+}
+
+func TestEventsHandler(t *testing.T) {
+	hub := events.NewHub(nil, nil)
+	api := API{
+		uptime: time.Now(),
+		logger: log.NewNopLogger(),
+		events: hub,
+		getAlertStatus: func(model.Fingerprint) types.AlertStatus {
+			return types.AlertStatus{State: types.AlertStateActive}
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(api.eventsHandler))
+	defer srv.Close()
+
+	for _, query := range []string{"?filter=foo=~[", "?type=foo"} {
+		resp, err := http.Get(srv.URL + query)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+
+	resp, err := http.Get(srv.URL + "?filter=team=a&type=alert&type=silence")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	now := time.Now()
+	newAlert := func(team string) *types.Alert {
+		return &types.Alert{Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "a", "team": model.LabelValue(team)},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		}}
+	}
+	// Events of other types or not matching the filter aren't sent.
+	hub.Publish(events.Event{Type: events.TypeAlert, Alert: newAlert("b")})
+	hub.Publish(events.Event{Type: events.TypeGroupFlush, Flush: &events.GroupFlush{Alerts: []*types.Alert{newAlert("a")}}})
+	hub.Publish(events.Event{Type: events.TypeAlert, Alert: newAlert("a")})
+	hub.PublishSilence(&silencepb.Silence{
+		Id:        "1",
+		Matchers:  []*silencepb.Matcher{{Type: silencepb.Matcher_EQUAL, Name: "team", Pattern: "a"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(time.Hour),
+		UpdatedAt: now,
+	})
+
+	scanner := bufio.NewScanner(resp.Body)
+	readEvent := func() (string, string) {
+		var typ, data string
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				break
+			}
+			if v, ok := strings.CutPrefix(line, "event: "); ok {
+				typ = v
+			}
+			if v, ok := strings.CutPrefix(line, "data: "); ok {
+				data = v
+			}
+		}
+		return typ, data
+	}
+
+	typ, data := readEvent()
+	require.Equal(t, "alert", typ)
+	var alert open_api_models.GettableAlert
+	require.NoError(t, json.Unmarshal([]byte(data), &alert))
+	require.Equal(t, "a", alert.Labels["team"])
+
+	typ, data = readEvent()
+	require.Equal(t, "silence", typ)
+	var sil open_api_models.GettableSilence
+	require.NoError(t, json.Unmarshal([]byte(data), &sil))
+	require.Equal(t, "1", *sil.ID)
+}
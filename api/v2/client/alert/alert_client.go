@@ -44,13 +44,135 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeleteAlertAck(params *DeleteAlertAckParams, opts ...ClientOption) (*DeleteAlertAckOK, error)
+
+	GetAlertAck(params *GetAlertAckParams, opts ...ClientOption) (*GetAlertAckOK, error)
+
+	GetAlertExplanation(params *GetAlertExplanationParams, opts ...ClientOption) (*GetAlertExplanationOK, error)
+
 	GetAlerts(params *GetAlertsParams, opts ...ClientOption) (*GetAlertsOK, error)
 
+	PostAlertAck(params *PostAlertAckParams, opts ...ClientOption) (*PostAlertAckOK, error)
+
 	PostAlerts(params *PostAlertsParams, opts ...ClientOption) (*PostAlertsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DeleteAlertAck Remove the acknowledgement of an alert
+*/
+func (a *Client) DeleteAlertAck(params *DeleteAlertAckParams, opts ...ClientOption) (*DeleteAlertAckOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteAlertAckParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteAlertAck",
+		Method:             "DELETE",
+		PathPattern:        "/alerts/{fingerprint}/ack",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteAlertAckReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteAlertAckOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteAlertAck: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetAlertAck Get the acknowledgement of an alert
+*/
+func (a *Client) GetAlertAck(params *GetAlertAckParams, opts ...ClientOption) (*GetAlertAckOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAlertAckParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAlertAck",
+		Method:             "GET",
+		PathPattern:        "/alerts/{fingerprint}/ack",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAlertAckReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAlertAckOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAlertAck: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetAlertExplanation Explain why an alert is or isn't suppressed
+*/
+func (a *Client) GetAlertExplanation(params *GetAlertExplanationParams, opts ...ClientOption) (*GetAlertExplanationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAlertExplanationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAlertExplanation",
+		Method:             "GET",
+		PathPattern:        "/alerts/{fingerprint}/explain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAlertExplanationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAlertExplanationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAlertExplanation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetAlerts Get a list of alerts
 */
@@ -89,6 +211,44 @@ func (a *Client) GetAlerts(params *GetAlertsParams, opts ...ClientOption) (*GetA
 	panic(msg)
 }
 
+/*
+PostAlertAck Acknowledge an alert, replacing any previous acknowledgement
+*/
+func (a *Client) PostAlertAck(params *PostAlertAckParams, opts ...ClientOption) (*PostAlertAckOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAlertAckParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "postAlertAck",
+		Method:             "POST",
+		PathPattern:        "/alerts/{fingerprint}/ack",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostAlertAckReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostAlertAckOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for postAlertAck: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostAlerts Create new Alerts
 */
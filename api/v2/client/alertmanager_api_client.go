@@ -27,6 +27,8 @@ import (
 	"github.com/prometheus/alertmanager/api/v2/client/alert"
 	"github.com/prometheus/alertmanager/api/v2/client/alertgroup"
 	"github.com/prometheus/alertmanager/api/v2/client/general"
+	"github.com/prometheus/alertmanager/api/v2/client/inhibition"
+	"github.com/prometheus/alertmanager/api/v2/client/notification"
 	"github.com/prometheus/alertmanager/api/v2/client/receiver"
 	"github.com/prometheus/alertmanager/api/v2/client/silence"
 )
@@ -76,6 +78,8 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Alertmanag
 	cli.Alert = alert.New(transport, formats)
 	cli.Alertgroup = alertgroup.New(transport, formats)
 	cli.General = general.New(transport, formats)
+	cli.Inhibition = inhibition.New(transport, formats)
+	cli.Notification = notification.New(transport, formats)
 	cli.Receiver = receiver.New(transport, formats)
 	cli.Silence = silence.New(transport, formats)
 	return cli
@@ -128,6 +132,10 @@ type AlertmanagerAPI struct {
 
 	General general.ClientService
 
+	Inhibition inhibition.ClientService
+
+	Notification notification.ClientService
+
 	Receiver receiver.ClientService
 
 	Silence silence.ClientService
@@ -141,6 +149,8 @@ func (c *AlertmanagerAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Alert.SetTransport(transport)
 	c.Alertgroup.SetTransport(transport)
 	c.General.SetTransport(transport)
+	c.Inhibition.SetTransport(transport)
+	c.Notification.SetTransport(transport)
 	c.Receiver.SetTransport(transport)
 	c.Silence.SetTransport(transport)
 }
@@ -46,6 +46,8 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	GetReceivers(params *GetReceiversParams, opts ...ClientOption) (*GetReceiversOK, error)
 
+	TestReceiver(params *TestReceiverParams, opts ...ClientOption) (*TestReceiverOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -87,6 +89,44 @@ func (a *Client) GetReceivers(params *GetReceiversParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+TestReceiver Send a test notification through each integration of a receiver, bypassing grouping, deduplication and the notification log.
+*/
+func (a *Client) TestReceiver(params *TestReceiverParams, opts ...ClientOption) (*TestReceiverOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTestReceiverParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "testReceiver",
+		Method:             "POST",
+		PathPattern:        "/receivers/{name}/test",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TestReceiverReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TestReceiverOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for testReceiver: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -75,6 +75,12 @@ DeleteSilenceParams contains all the parameters to send to the API endpoint
 */
 type DeleteSilenceParams struct {
 
+	/* Author.
+
+	   The author of the expiration, recorded in the history of the silence
+	*/
+	Author *string
+
 	/* SilenceID.
 
 	   ID of the silence to get
@@ -136,6 +142,17 @@ func (o *DeleteSilenceParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAuthor adds the author to the delete silence params
+func (o *DeleteSilenceParams) WithAuthor(author *string) *DeleteSilenceParams {
+	o.SetAuthor(author)
+	return o
+}
+
+// SetAuthor adds the author to the delete silence params
+func (o *DeleteSilenceParams) SetAuthor(author *string) {
+	o.Author = author
+}
+
 // WithSilenceID adds the silenceID to the delete silence params
 func (o *DeleteSilenceParams) WithSilenceID(silenceID strfmt.UUID) *DeleteSilenceParams {
 	o.SetSilenceID(silenceID)
@@ -155,6 +172,23 @@ func (o *DeleteSilenceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
+	if o.Author != nil {
+
+		// query param author
+		var qrAuthor string
+
+		if o.Author != nil {
+			qrAuthor = *o.Author
+		}
+		qAuthor := qrAuthor
+		if qAuthor != "" {
+
+			if err := r.SetQueryParam("author", qAuthor); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param silenceID
 	if err := r.SetPathParam("silenceID", o.SilenceID.String()); err != nil {
 		return err
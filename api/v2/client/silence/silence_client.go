@@ -48,10 +48,14 @@ type ClientService interface {
 
 	GetSilence(params *GetSilenceParams, opts ...ClientOption) (*GetSilenceOK, error)
 
+	GetSilenceHistory(params *GetSilenceHistoryParams, opts ...ClientOption) (*GetSilenceHistoryOK, error)
+
 	GetSilences(params *GetSilencesParams, opts ...ClientOption) (*GetSilencesOK, error)
 
 	PostSilences(params *PostSilencesParams, opts ...ClientOption) (*PostSilencesOK, error)
 
+	PreviewSilence(params *PreviewSilenceParams, opts ...ClientOption) (*PreviewSilenceOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -131,6 +135,44 @@ func (a *Client) GetSilence(params *GetSilenceParams, opts ...ClientOption) (*Ge
 	panic(msg)
 }
 
+/*
+GetSilenceHistory Get the changes made to a silence and to the silences it replaced, oldest first
+*/
+func (a *Client) GetSilenceHistory(params *GetSilenceHistoryParams, opts ...ClientOption) (*GetSilenceHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSilenceHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getSilenceHistory",
+		Method:             "GET",
+		PathPattern:        "/silence/{silenceID}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSilenceHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSilenceHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getSilenceHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetSilences Get a list of silences
 */
@@ -207,6 +249,44 @@ func (a *Client) PostSilences(params *PostSilencesParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+PreviewSilence Get the current alerts a silence would mute without creating it
+*/
+func (a *Client) PreviewSilence(params *PreviewSilenceParams, opts ...ClientOption) (*PreviewSilenceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "previewSilence",
+		Method:             "POST",
+		PathPattern:        "/silences/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PreviewSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PreviewSilenceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for previewSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
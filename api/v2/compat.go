@@ -15,12 +15,18 @@ package v2
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	prometheus_model "github.com/prometheus/common/model"
 
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/inhibit"
+	"github.com/prometheus/alertmanager/nflog/nflogpb"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -33,10 +39,11 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 	state := string(types.CalcSilenceState(s.StartsAt, s.EndsAt))
 	sil := open_api_models.GettableSilence{
 		Silence: open_api_models.Silence{
-			StartsAt:  &start,
-			EndsAt:    &end,
-			Comment:   &s.Comment,
-			CreatedBy: &s.CreatedBy,
+			StartsAt:      &start,
+			EndsAt:        &end,
+			Comment:       &s.Comment,
+			CreatedBy:     &s.CreatedBy,
+			TimeIntervals: s.TimeIntervals,
 		},
 		ID:        &s.Id,
 		UpdatedAt: &updated,
@@ -45,7 +52,18 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 		},
 	}
 
-	for _, m := range s.Matchers {
+	matchers, err := matchersFromProto(s.Matchers)
+	if err != nil {
+		return sil, fmt.Errorf("%w in silence '%v'", err, s.Id)
+	}
+	sil.Matchers = matchers
+
+	return sil, nil
+}
+
+func matchersFromProto(ms []*silencepb.Matcher) (open_api_models.Matchers, error) {
+	var res open_api_models.Matchers
+	for _, m := range ms {
 		matcher := &open_api_models.Matcher{
 			Name:  &m.Name,
 			Value: &m.Pattern,
@@ -66,26 +84,50 @@ func GettableSilenceFromProto(s *silencepb.Silence) (open_api_models.GettableSil
 			matcher.IsEqual = &f
 			matcher.IsRegex = &t
 		default:
-			return sil, fmt.Errorf(
-				"unknown matcher type for matcher '%v' in silence '%v'",
-				m.Name,
-				s.Id,
-			)
+			return nil, fmt.Errorf("unknown matcher type for matcher '%v'", m.Name)
 		}
-		sil.Matchers = append(sil.Matchers, matcher)
+		res = append(res, matcher)
 	}
+	return res, nil
+}
 
-	return sil, nil
+// SilenceRevisionsFromProto converts the revisions of a *silencepb.Silence to
+// open_api_models.SilenceRevisions.
+func SilenceRevisionsFromProto(s *silencepb.Silence) (open_api_models.SilenceRevisions, error) {
+	res := make(open_api_models.SilenceRevisions, 0, len(s.Revisions))
+	for _, r := range s.Revisions {
+		matchers, err := matchersFromProto(r.Matchers)
+		if err != nil {
+			return nil, fmt.Errorf("%w in revision of silence '%v'", err, r.SilenceId)
+		}
+		action := strings.ToLower(r.Action.String())
+		silenceID := r.SilenceId
+		ts := strfmt.DateTime(r.Timestamp)
+		start := strfmt.DateTime(r.StartsAt)
+		end := strfmt.DateTime(r.EndsAt)
+		res = append(res, &open_api_models.SilenceRevision{
+			Action:    &action,
+			SilenceID: &silenceID,
+			Author:    r.Author,
+			Timestamp: &ts,
+			Matchers:  matchers,
+			StartsAt:  &start,
+			EndsAt:    &end,
+			Comment:   r.Comment,
+		})
+	}
+	return res, nil
 }
 
 // PostableSilenceToProto converts *open_api_models.PostableSilenc to *silencepb.Silence.
 func PostableSilenceToProto(s *open_api_models.PostableSilence) (*silencepb.Silence, error) {
 	sil := &silencepb.Silence{
-		Id:        s.ID,
-		StartsAt:  time.Time(*s.StartsAt),
-		EndsAt:    time.Time(*s.EndsAt),
-		Comment:   *s.Comment,
-		CreatedBy: *s.CreatedBy,
+		Id:            s.ID,
+		StartsAt:      time.Time(*s.StartsAt),
+		EndsAt:        time.Time(*s.EndsAt),
+		Comment:       *s.Comment,
+		CreatedBy:     *s.CreatedBy,
+		TimeIntervals: s.TimeIntervals,
 	}
 	for _, m := range s.Matchers {
 		matcher := &silencepb.Matcher{
@@ -155,9 +197,164 @@ func AlertToOpenAPIAlert(alert *types.Alert, status types.AlertStatus, receivers
 		aa.Status.InhibitedBy = []string{}
 	}
 
+	if status.Acknowledgement != nil {
+		aa.Status.Acknowledgement = AcknowledgementToOpenAPI(status.Acknowledgement)
+	}
+
 	return aa
 }
 
+// NotificationEntryToOpenAPI converts *nflogpb.Entry to *open_api_models.Notification.
+func NotificationEntryToOpenAPI(e *nflogpb.Entry) *open_api_models.Notification {
+	hashes := func(hs []uint64) []string {
+		res := make([]string, 0, len(hs))
+		for _, h := range hs {
+			res = append(res, fmt.Sprintf("%016x", h))
+		}
+		return res
+	}
+
+	integrationIdx := int64(e.Receiver.Idx)
+	groupKey := string(e.GroupKey)
+	ts := strfmt.DateTime(e.Timestamp)
+	return &open_api_models.Notification{
+		Receiver:         &e.Receiver.GroupName,
+		Integration:      &e.Receiver.Integration,
+		IntegrationIndex: &integrationIdx,
+		GroupKey:         &groupKey,
+		Timestamp:        &ts,
+		FiringAlerts:     hashes(e.FiringAlerts),
+		ResolvedAlerts:   hashes(e.ResolvedAlerts),
+	}
+}
+
+// DeadLetterToOpenAPI converts *notify.DeadLetter to *open_api_models.DeadLetter.
+func DeadLetterToOpenAPI(d *notify.DeadLetter) *open_api_models.DeadLetter {
+	fps := d.Fingerprints()
+	fingerprints := make([]string, 0, len(fps))
+	for _, fp := range fps {
+		fingerprints = append(fingerprints, fp.String())
+	}
+
+	ts := strfmt.DateTime(d.Timestamp)
+	idx := int64(d.Index)
+	redrives := int64(d.Redrives)
+	return &open_api_models.DeadLetter{
+		ID:               &d.ID,
+		Timestamp:        &ts,
+		Receiver:         &d.Receiver,
+		Integration:      &d.Integration,
+		IntegrationIndex: &idx,
+		GroupKey:         &d.GroupKey,
+		GroupLabels:      ModelLabelSetToAPILabelSet(d.GroupLabels),
+		Fingerprints:     fingerprints,
+		Reason:           &d.Reason,
+		Error:            &d.Error,
+		Redrives:         &redrives,
+	}
+}
+
+// CircuitBreakerStatusToOpenAPI converts notify.CircuitBreakerStatus to *open_api_models.IntegrationStatus.
+func CircuitBreakerStatusToOpenAPI(s notify.CircuitBreakerStatus) *open_api_models.IntegrationStatus {
+	idx := int64(s.Index)
+	state := s.State.String()
+	failures := int64(s.ConsecutiveFailures)
+	name := s.Integration
+	res := &open_api_models.IntegrationStatus{
+		Name:  &name,
+		Index: &idx,
+		CircuitBreaker: &open_api_models.CircuitBreakerStatus{
+			State:               &state,
+			ConsecutiveFailures: &failures,
+		},
+	}
+	if !s.OpenedAt.IsZero() {
+		res.CircuitBreaker.OpenedAt = strfmt.DateTime(s.OpenedAt)
+	}
+	return res
+}
+
+// AcknowledgementToOpenAPI converts *types.Acknowledgement to *open_api_models.Acknowledgement.
+func AcknowledgementToOpenAPI(a *types.Acknowledgement) *open_api_models.Acknowledgement {
+	createdAt := strfmt.DateTime(a.CreatedAt)
+	res := &open_api_models.Acknowledgement{
+		AcknowledgedBy: &a.AcknowledgedBy,
+		Comment:        a.Comment,
+		CreatedAt:      &createdAt,
+	}
+	if !a.ExpiresAt.IsZero() {
+		res.ExpiresAt = strfmt.DateTime(a.ExpiresAt)
+	}
+	return res
+}
+
+// InhibitRuleExplanationToOpenAPI converts inhibit.RuleExplanation to *open_api_models.InhibitRuleExplanation.
+func InhibitRuleExplanationToOpenAPI(e inhibit.RuleExplanation) *open_api_models.InhibitRuleExplanation {
+	index := int64(e.Index)
+	res := &open_api_models.InhibitRuleExplanation{
+		Index:          &index,
+		Name:           e.Name,
+		SourceMatchers: matchersToStrings(e.Rule.SourceMatchers),
+		TargetMatchers: matchersToStrings(e.Rule.TargetMatchers),
+		Equal:          equalLabelsToStrings(e.Rule.Equal),
+		TargetMatched:  &e.TargetMatched,
+		Sources:        make([]*open_api_models.InhibitSourceExplanation, 0, len(e.Sources)),
+		Inhibited:      &e.Inhibited,
+	}
+	for _, s := range e.Sources {
+		fp := s.Fingerprint.String()
+		twoSided, belowMinDuration, inhibits := s.TwoSided, s.BelowMinDuration, s.Inhibits
+		src := &open_api_models.InhibitSourceExplanation{
+			Fingerprint:      &fp,
+			Labels:           ModelLabelSetToAPILabelSet(s.Labels),
+			UnequalLabels:    make([]string, 0, len(s.Unequal)),
+			TwoSided:         &twoSided,
+			BelowMinDuration: &belowMinDuration,
+			Inhibits:         &inhibits,
+		}
+		for _, ln := range s.Unequal {
+			src.UnequalLabels = append(src.UnequalLabels, string(ln))
+		}
+		res.Sources = append(res.Sources, src)
+	}
+	if e.Inhibited {
+		res.InhibitedBy = e.InhibitedBy.String()
+	}
+	return res
+}
+
+// InhibitRuleToOpenAPI converts *inhibit.InhibitRule to *open_api_models.Inhibition,
+// without its source and target alerts.
+func InhibitRuleToOpenAPI(r *inhibit.InhibitRule, index int) *open_api_models.Inhibition {
+	i := int64(index)
+	return &open_api_models.Inhibition{
+		Index:          &i,
+		Name:           r.Name,
+		SourceMatchers: matchersToStrings(r.SourceMatchers),
+		TargetMatchers: matchersToStrings(r.TargetMatchers),
+		Equal:          equalLabelsToStrings(r.Equal),
+		Sources:        open_api_models.GettableAlerts{},
+		Targets:        open_api_models.GettableAlerts{},
+	}
+}
+
+func matchersToStrings(ms labels.Matchers) []string {
+	res := make([]string, 0, len(ms))
+	for _, m := range ms {
+		res = append(res, m.String())
+	}
+	return res
+}
+
+func equalLabelsToStrings(equal map[prometheus_model.LabelName]struct{}) []string {
+	res := make([]string, 0, len(equal))
+	for ln := range equal {
+		res = append(res, string(ln))
+	}
+	sort.Strings(res)
+	return res
+}
+
 // OpenAPIAlertsToAlerts converts open_api_models.PostableAlerts to []*types.Alert.
 func OpenAPIAlertsToAlerts(apiAlerts open_api_models.PostableAlerts) []*types.Alert {
 	alerts := []*types.Alert{}
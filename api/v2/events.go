@@ -0,0 +1,187 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/log/level"
+
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/events"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/types"
+)
+
+const (
+	// eventsBufferSize is the number of events buffered for a client of the
+	// event stream before it is disconnected.
+	eventsBufferSize = 1024
+	// eventsHeartbeatInterval is the interval at which comments are sent on
+	// an idle event stream to keep the connection open.
+	eventsHeartbeatInterval = 15 * time.Second
+)
+
+// groupFlushEvent is the payload of group_flush events.
+type groupFlushEvent struct {
+	Receiver string                           `json:"receiver"`
+	GroupKey string                           `json:"groupKey"`
+	Labels   open_api_models.LabelSet         `json:"labels"`
+	Alerts   []*open_api_models.GettableAlert `json:"alerts"`
+	Success  bool                             `json:"success"`
+}
+
+// eventsHandler streams the changes of alerts, silences and aggregation groups
+// as server-sent events. The events can be restricted to the given types and
+// to the ones matching the given filter.
+func (api *API) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	logger := api.requestLogger(r)
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if api.events == nil {
+		http.Error(w, "event stream is disabled", http.StatusNotFound)
+		return
+	}
+
+	matchers, err := parseFilter(r.URL.Query()["filter"])
+	if err != nil {
+		level.Debug(logger).Log("msg", "Failed to parse matchers", "err", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var eventTypes map[events.Type]struct{}
+	for _, s := range r.URL.Query()["type"] {
+		t, err := events.ParseType(s)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if eventTypes == nil {
+			eventTypes = map[events.Type]struct{}{}
+		}
+		eventTypes[t] = struct{}{}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub := api.events.Subscribe(eventsBufferSize)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(eventsHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.Done():
+			level.Debug(logger).Log("msg", "Closing event stream which doesn't keep up with the events")
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case e := <-sub.C():
+			if eventTypes != nil {
+				if _, ok := eventTypes[e.Type]; !ok {
+					continue
+				}
+			}
+			payload := api.eventPayload(e, matchers)
+			if payload == nil {
+				continue
+			}
+			b, err := json.Marshal(payload)
+			if err != nil {
+				level.Error(logger).Log("msg", "Failed to encode event", "type", e.Type, "err", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, b); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// eventPayload returns the API representation of the event, nil if it doesn't
+// match the matchers.
+func (api *API) eventPayload(e events.Event, matchers []*labels.Matcher) interface{} {
+	switch e.Type {
+	case events.TypeAlert:
+		if !alertMatchesFilterLabels(&e.Alert.Alert, matchers) {
+			return nil
+		}
+		return api.openAPIAlert(e.Alert)
+	case events.TypeSilence:
+		if !CheckSilenceMatchesFilterLabels(e.Silence, matchers) {
+			return nil
+		}
+		sil, err := GettableSilenceFromProto(e.Silence)
+		if err != nil {
+			level.Error(api.logger).Log("msg", "Failed to convert silence", "err", err)
+			return nil
+		}
+		return &sil
+	case events.TypeGroupFlush:
+		res := &groupFlushEvent{
+			Receiver: e.Flush.Receiver,
+			GroupKey: e.Flush.GroupKey,
+			Labels:   ModelLabelSetToAPILabelSet(e.Flush.GroupLabels),
+			Alerts:   make([]*open_api_models.GettableAlert, 0, len(e.Flush.Alerts)),
+			Success:  e.Flush.Success,
+		}
+		for _, a := range e.Flush.Alerts {
+			if alertMatchesFilterLabels(&a.Alert, matchers) {
+				res.Alerts = append(res.Alerts, api.openAPIAlert(a))
+			}
+		}
+		// Like for the alert groups, the groups without matching alerts
+		// are left out.
+		if len(res.Alerts) == 0 {
+			return nil
+		}
+		return res
+	}
+	return nil
+}
+
+func (api *API) openAPIAlert(a *types.Alert) *open_api_models.GettableAlert {
+	var receivers []string
+	api.mtx.RLock()
+	if api.route != nil {
+		for _, r := range api.route.Match(a.Labels) {
+			receivers = append(receivers, r.RouteOpts.Receiver)
+		}
+	}
+	api.mtx.RUnlock()
+
+	return AlertToOpenAPIAlert(a, api.getAlertStatus(a.Fingerprint()), receivers)
+}
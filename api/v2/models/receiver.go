@@ -21,6 +21,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -33,6 +34,9 @@ import (
 // swagger:model receiver
 type Receiver struct {
 
+	// State of the integrations of the receiver. Only set when circuit breakers are enabled.
+	Integrations []*IntegrationStatus `json:"integrations,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
@@ -42,6 +46,10 @@ type Receiver struct {
 func (m *Receiver) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateIntegrations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -52,6 +60,32 @@ func (m *Receiver) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Receiver) validateIntegrations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Integrations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Integrations); i++ {
+		if swag.IsZero(m.Integrations[i]) { // not required
+			continue
+		}
+
+		if m.Integrations[i] != nil {
+			if err := m.Integrations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("integrations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("integrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Receiver) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -61,8 +95,42 @@ func (m *Receiver) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this receiver based on context it is used
+// ContextValidate validate this receiver based on the context it is used
 func (m *Receiver) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateIntegrations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Receiver) contextValidateIntegrations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Integrations); i++ {
+
+		if m.Integrations[i] != nil {
+
+			if swag.IsZero(m.Integrations[i]) { // not required
+				return nil
+			}
+
+			if err := m.Integrations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("integrations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("integrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -54,6 +54,9 @@ type Silence struct {
 	// Required: true
 	// Format: date-time
 	StartsAt *strfmt.DateTime `json:"startsAt"`
+
+	// Names of time intervals of the configuration. If set, the silence only mutes alerts while the current time is in one of them.
+	TimeIntervals []string `json:"timeIntervals,omitempty"`
 }
 
 // Validate validates this silence
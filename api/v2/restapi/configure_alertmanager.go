@@ -28,6 +28,8 @@ import (
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/alertgroup"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
+	"github.com/prometheus/alertmanager/api/v2/restapi/operations/inhibition"
+	"github.com/prometheus/alertmanager/api/v2/restapi/operations/notification"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
 )
@@ -56,11 +58,26 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	if api.AlertDeleteAlertAckHandler == nil {
+		api.AlertDeleteAlertAckHandler = alert.DeleteAlertAckHandlerFunc(func(params alert.DeleteAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertAck has not yet been implemented")
+		})
+	}
 	if api.SilenceDeleteSilenceHandler == nil {
 		api.SilenceDeleteSilenceHandler = silence.DeleteSilenceHandlerFunc(func(params silence.DeleteSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.DeleteSilence has not yet been implemented")
 		})
 	}
+	if api.AlertGetAlertAckHandler == nil {
+		api.AlertGetAlertAckHandler = alert.GetAlertAckHandlerFunc(func(params alert.GetAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertAck has not yet been implemented")
+		})
+	}
+	if api.AlertGetAlertExplanationHandler == nil {
+		api.AlertGetAlertExplanationHandler = alert.GetAlertExplanationHandlerFunc(func(params alert.GetAlertExplanationParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertExplanation has not yet been implemented")
+		})
+	}
 	if api.AlertgroupGetAlertGroupsHandler == nil {
 		api.AlertgroupGetAlertGroupsHandler = alertgroup.GetAlertGroupsHandlerFunc(func(params alertgroup.GetAlertGroupsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alertgroup.GetAlertGroups has not yet been implemented")
@@ -71,6 +88,21 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation alert.GetAlerts has not yet been implemented")
 		})
 	}
+	if api.NotificationGetDeadLettersHandler == nil {
+		api.NotificationGetDeadLettersHandler = notification.GetDeadLettersHandlerFunc(func(params notification.GetDeadLettersParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.GetDeadLetters has not yet been implemented")
+		})
+	}
+	if api.InhibitionGetInhibitionsHandler == nil {
+		api.InhibitionGetInhibitionsHandler = inhibition.GetInhibitionsHandlerFunc(func(params inhibition.GetInhibitionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation inhibition.GetInhibitions has not yet been implemented")
+		})
+	}
+	if api.NotificationGetNotificationsHandler == nil {
+		api.NotificationGetNotificationsHandler = notification.GetNotificationsHandlerFunc(func(params notification.GetNotificationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.GetNotifications has not yet been implemented")
+		})
+	}
 	if api.ReceiverGetReceiversHandler == nil {
 		api.ReceiverGetReceiversHandler = receiver.GetReceiversHandlerFunc(func(params receiver.GetReceiversParams) middleware.Responder {
 			return middleware.NotImplemented("operation receiver.GetReceivers has not yet been implemented")
@@ -81,6 +113,11 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation silence.GetSilence has not yet been implemented")
 		})
 	}
+	if api.SilenceGetSilenceHistoryHandler == nil {
+		api.SilenceGetSilenceHistoryHandler = silence.GetSilenceHistoryHandlerFunc(func(params silence.GetSilenceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceHistory has not yet been implemented")
+		})
+	}
 	if api.SilenceGetSilencesHandler == nil {
 		api.SilenceGetSilencesHandler = silence.GetSilencesHandlerFunc(func(params silence.GetSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilences has not yet been implemented")
@@ -91,6 +128,11 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation general.GetStatus has not yet been implemented")
 		})
 	}
+	if api.AlertPostAlertAckHandler == nil {
+		api.AlertPostAlertAckHandler = alert.PostAlertAckHandlerFunc(func(params alert.PostAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.PostAlertAck has not yet been implemented")
+		})
+	}
 	if api.AlertPostAlertsHandler == nil {
 		api.AlertPostAlertsHandler = alert.PostAlertsHandlerFunc(func(params alert.PostAlertsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alert.PostAlerts has not yet been implemented")
@@ -101,6 +143,21 @@ func configureAPI(api *operations.AlertmanagerAPI) http.Handler {
 			return middleware.NotImplemented("operation silence.PostSilences has not yet been implemented")
 		})
 	}
+	if api.SilencePreviewSilenceHandler == nil {
+		api.SilencePreviewSilenceHandler = silence.PreviewSilenceHandlerFunc(func(params silence.PreviewSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.PreviewSilence has not yet been implemented")
+		})
+	}
+	if api.NotificationRedriveDeadLetterHandler == nil {
+		api.NotificationRedriveDeadLetterHandler = notification.RedriveDeadLetterHandlerFunc(func(params notification.RedriveDeadLetterParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.RedriveDeadLetter has not yet been implemented")
+		})
+	}
+	if api.ReceiverTestReceiverHandler == nil {
+		api.ReceiverTestReceiverHandler = receiver.TestReceiverHandlerFunc(func(params receiver.TestReceiverParams) middleware.Responder {
+			return middleware.NotImplemented("operation receiver.TestReceiver has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
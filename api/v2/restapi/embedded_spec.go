@@ -210,6 +210,252 @@ func init() {
         }
       }
     },
+    "/alerts/{fingerprint}/ack": {
+      "get": {
+        "description": "Get the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertAck",
+        "responses": {
+          "200": {
+            "description": "Get acknowledgement response",
+            "schema": {
+              "$ref": "#/definitions/acknowledgement"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "The alert is not acknowledged"
+          }
+        }
+      },
+      "post": {
+        "description": "Acknowledge an alert, replacing any previous acknowledgement",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "postAlertAck",
+        "parameters": [
+          {
+            "description": "The acknowledgement to create",
+            "name": "acknowledgement",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableAcknowledgement"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Create acknowledgement response",
+            "schema": {
+              "$ref": "#/definitions/acknowledgement"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "An alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "delete": {
+        "description": "Remove the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "deleteAlertAck",
+        "responses": {
+          "200": {
+            "description": "Delete acknowledgement response"
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "The alert is not acknowledged"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/alerts/{fingerprint}/explain": {
+      "get": {
+        "description": "Explain why an alert is or isn't suppressed",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertExplanation",
+        "responses": {
+          "200": {
+            "description": "Get alert explanation response",
+            "schema": {
+              "$ref": "#/definitions/alertExplanation"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "An alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/inhibitions": {
+      "get": {
+        "description": "Get the inhibit rules with their active source alerts and the target alerts they inhibit",
+        "tags": [
+          "inhibition"
+        ],
+        "operationId": "getInhibitions",
+        "responses": {
+          "200": {
+            "description": "Get inhibitions response",
+            "schema": {
+              "$ref": "#/definitions/inhibitions"
+            }
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/notifications": {
+      "get": {
+        "description": "Get the successful notifications of the aggregation groups to each integration, most recent first. A bounded number of notifications is kept for each aggregation group and integration until the data retention time has passed",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "getNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the receiver to filter notifications by",
+            "name": "receiver",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Integration to filter notifications by, e.g. email",
+            "name": "integration",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Key of the aggregation group to filter notifications by",
+            "name": "groupKey",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return notifications sent at or after this time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return notifications sent at or before this time",
+            "name": "until",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get notifications response",
+            "schema": {
+              "$ref": "#/definitions/notifications"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/notifications/dead-letters": {
+      "get": {
+        "description": "Get the failed notifications that were given up on, oldest first",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "getDeadLetters",
+        "responses": {
+          "200": {
+            "description": "Get dead letters response",
+            "schema": {
+              "$ref": "#/definitions/deadLetters"
+            }
+          }
+        }
+      }
+    },
+    "/notifications/dead-letters/{id}/redrive": {
+      "post": {
+        "description": "Send a failed notification again through its integration. It is removed from the dead letters on success.",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "redriveDeadLetter",
+        "responses": {
+          "200": {
+            "description": "Re-drive dead letter response"
+          },
+          "404": {
+            "description": "A dead letter with the specified ID was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "ID of the dead letter",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/receivers": {
       "get": {
         "description": "Get list of all receivers (name of notification integrations)",
@@ -230,6 +476,51 @@ func init() {
         }
       }
     },
+    "/receivers/{name}/test": {
+      "post": {
+        "description": "Send a test notification through each integration of a receiver, bypassing grouping, deduplication and the notification log.",
+        "tags": [
+          "receiver"
+        ],
+        "operationId": "testReceiver",
+        "parameters": [
+          {
+            "description": "The alerts to notify about. A firing test alert is used if none is given.",
+            "name": "alerts",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/postableAlerts"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Test receiver response",
+            "schema": {
+              "$ref": "#/definitions/receiverTestResult"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "description": "A receiver with the specified name was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Name of the receiver",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/silence/{silenceID}": {
       "get": {
         "description": "Get a silence by its ID",
@@ -266,6 +557,12 @@ func init() {
             "name": "silenceID",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The author of the expiration, recorded in the history of the silence",
+            "name": "author",
+            "in": "query"
           }
         ],
         "responses": {
@@ -291,6 +588,39 @@ func init() {
         }
       ]
     },
+    "/silence/{silenceID}/history": {
+      "get": {
+        "description": "Get the changes made to a silence and to the silences it replaced, oldest first",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilenceHistory",
+        "responses": {
+          "200": {
+            "description": "Get silence history response",
+            "schema": {
+              "$ref": "#/definitions/silenceRevisions"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to get the history of",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/silences": {
       "get": {
         "description": "Get a list of silences",
@@ -366,6 +696,40 @@ func init() {
         }
       }
     },
+    "/silences/preview": {
+      "post": {
+        "description": "Get the current alerts a silence would mute without creating it",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "previewSilence",
+        "parameters": [
+          {
+            "description": "The silence to preview",
+            "name": "silence",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableSilence"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Silence preview response",
+            "schema": {
+              "$ref": "#/definitions/silencePreview"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "500": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
     "/status": {
       "get": {
         "description": "Get current status of an Alertmanager instance and its cluster",
@@ -385,6 +749,29 @@ func init() {
     }
   },
   "definitions": {
+    "acknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy",
+        "createdAt"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "alert": {
       "type": "object",
       "required": [
@@ -400,6 +787,40 @@ func init() {
         }
       }
     },
+    "alertExplanation": {
+      "type": "object",
+      "required": [
+        "alert",
+        "inhibitRules",
+        "silences",
+        "routes"
+      ],
+      "properties": {
+        "alert": {
+          "$ref": "#/definitions/gettableAlert"
+        },
+        "inhibitRules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inhibitRuleExplanation"
+          }
+        },
+        "routes": {
+          "description": "Routes matching the alert.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeExplanation"
+          }
+        },
+        "silences": {
+          "description": "Active and pending silences matching the alert.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/silenceExplanation"
+          }
+        }
+      }
+    },
     "alertGroup": {
       "type": "object",
       "required": [
@@ -436,12 +857,22 @@ func init() {
         "inhibitedBy"
       ],
       "properties": {
+        "acknowledgement": {
+          "$ref": "#/definitions/acknowledgement"
+        },
         "inhibitedBy": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
+        "limitedBy": {
+          "description": "Receivers of the routes for which the alert was not added to an aggregation group because a limit was reached.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "silencedBy": {
           "type": "array",
           "items": {
@@ -493,6 +924,31 @@ func init() {
         }
       }
     },
+    "circuitBreakerStatus": {
+      "type": "object",
+      "required": [
+        "state",
+        "consecutiveFailures"
+      ],
+      "properties": {
+        "consecutiveFailures": {
+          "type": "integer"
+        },
+        "openedAt": {
+          "description": "Time the circuit breaker last opened. Not set if it is closed.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "closed",
+            "open",
+            "half-open"
+          ]
+        }
+      }
+    },
     "clusterStatus": {
       "type": "object",
       "required": [
@@ -518,6 +974,70 @@ func init() {
         }
       }
     },
+    "deadLetter": {
+      "type": "object",
+      "required": [
+        "id",
+        "timestamp",
+        "receiver",
+        "integration",
+        "integrationIndex",
+        "groupKey",
+        "groupLabels",
+        "fingerprints",
+        "reason",
+        "error",
+        "redrives"
+      ],
+      "properties": {
+        "error": {
+          "description": "Error of the last attempt.",
+          "type": "string"
+        },
+        "fingerprints": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupKey": {
+          "type": "string"
+        },
+        "groupLabels": {
+          "$ref": "#/definitions/labelSet"
+        },
+        "id": {
+          "type": "string"
+        },
+        "integration": {
+          "type": "string"
+        },
+        "integrationIndex": {
+          "type": "integer"
+        },
+        "reason": {
+          "description": "Failure reason of the last attempt.",
+          "type": "string"
+        },
+        "receiver": {
+          "type": "string"
+        },
+        "redrives": {
+          "description": "Number of failed attempts to re-drive the notification.",
+          "type": "integer"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "deadLetters": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/deadLetter"
+      }
+    },
     "gettableAlert": {
       "allOf": [
         {
@@ -561,48 +1081,241 @@ func init() {
             }
           }
         },
-        {
-          "$ref": "#/definitions/alert"
+        {
+          "$ref": "#/definitions/alert"
+        }
+      ]
+    },
+    "gettableAlerts": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/gettableAlert"
+      }
+    },
+    "gettableSilence": {
+      "allOf": [
+        {
+          "type": "object",
+          "required": [
+            "id",
+            "status",
+            "updatedAt"
+          ],
+          "properties": {
+            "id": {
+              "type": "string"
+            },
+            "status": {
+              "$ref": "#/definitions/silenceStatus"
+            },
+            "updatedAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
+        {
+          "$ref": "#/definitions/silence"
+        }
+      ]
+    },
+    "gettableSilences": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/gettableSilence"
+      }
+    },
+    "inhibitRuleExplanation": {
+      "type": "object",
+      "required": [
+        "index",
+        "sourceMatchers",
+        "targetMatchers",
+        "equal",
+        "targetMatched",
+        "sources",
+        "inhibited"
+      ],
+      "properties": {
+        "equal": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "index": {
+          "description": "Position of the inhibit rule in the configuration.",
+          "type": "integer"
+        },
+        "inhibited": {
+          "description": "Whether the alert is inhibited by the rule.",
+          "type": "boolean"
+        },
+        "inhibitedBy": {
+          "description": "Fingerprint of the source alert inhibiting the alert.",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sources": {
+          "description": "Unresolved alerts matching the source matchers.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inhibitSourceExplanation"
+          }
+        },
+        "targetMatched": {
+          "description": "Whether the target matchers match the alert. The source alerts are only evaluated if they do.",
+          "type": "boolean"
+        },
+        "targetMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inhibitSourceExplanation": {
+      "type": "object",
+      "required": [
+        "fingerprint",
+        "labels",
+        "unequalLabels",
+        "twoSided",
+        "belowMinDuration",
+        "inhibits"
+      ],
+      "properties": {
+        "belowMinDuration": {
+          "description": "Whether the source alert is disregarded because it hasn't been firing for the minimum source duration of the rule yet.",
+          "type": "boolean"
+        },
+        "fingerprint": {
+          "type": "string"
+        },
+        "inhibits": {
+          "description": "Whether the source alert inhibits the alert.",
+          "type": "boolean"
+        },
+        "labels": {
+          "$ref": "#/definitions/labelSet"
+        },
+        "twoSided": {
+          "description": "Whether the source alert is disregarded because both alerts match the source and target matchers.",
+          "type": "boolean"
+        },
+        "unequalLabels": {
+          "description": "Equal labels of the rule whose values differ from the ones of the alert.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inhibition": {
+      "type": "object",
+      "required": [
+        "index",
+        "sourceMatchers",
+        "targetMatchers",
+        "equal",
+        "sources",
+        "targets"
+      ],
+      "properties": {
+        "equal": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "index": {
+          "description": "Position of the inhibit rule in the configuration.",
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sources": {
+          "description": "Source alerts currently inhibiting the matching target alerts.",
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "targetMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "targets": {
+          "description": "Target alerts currently inhibited by the rule.",
+          "$ref": "#/definitions/gettableAlerts"
         }
-      ]
+      }
     },
-    "gettableAlerts": {
+    "inhibitions": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/gettableAlert"
+        "$ref": "#/definitions/inhibition"
       }
     },
-    "gettableSilence": {
-      "allOf": [
-        {
-          "type": "object",
-          "required": [
-            "id",
-            "status",
-            "updatedAt"
-          ],
-          "properties": {
-            "id": {
-              "type": "string"
-            },
-            "status": {
-              "$ref": "#/definitions/silenceStatus"
-            },
-            "updatedAt": {
-              "type": "string",
-              "format": "date-time"
-            }
-          }
+    "integrationStatus": {
+      "type": "object",
+      "required": [
+        "name",
+        "index"
+      ],
+      "properties": {
+        "circuitBreaker": {
+          "$ref": "#/definitions/circuitBreakerStatus"
         },
-        {
-          "$ref": "#/definitions/silence"
+        "index": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
         }
-      ]
+      }
     },
-    "gettableSilences": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/gettableSilence"
+    "integrationTestResult": {
+      "type": "object",
+      "required": [
+        "name",
+        "index",
+        "success",
+        "latencySeconds"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error returned by the integration if the notification failed.",
+          "type": "string"
+        },
+        "index": {
+          "type": "integer"
+        },
+        "latencySeconds": {
+          "type": "number"
+        },
+        "name": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
       }
     },
     "labelSet": {
@@ -641,6 +1354,56 @@ func init() {
         "$ref": "#/definitions/matcher"
       }
     },
+    "notification": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "integration",
+        "integrationIndex",
+        "groupKey",
+        "timestamp",
+        "firingAlerts",
+        "resolvedAlerts"
+      ],
+      "properties": {
+        "firingAlerts": {
+          "description": "Hashes of the labels of the alerts that were firing at notification time.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupKey": {
+          "type": "string"
+        },
+        "integration": {
+          "type": "string"
+        },
+        "integrationIndex": {
+          "type": "integer"
+        },
+        "receiver": {
+          "type": "string"
+        },
+        "resolvedAlerts": {
+          "description": "Hashes of the labels of the alerts that were resolved at notification time.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "notifications": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/notification"
+      }
+    },
     "peerStatus": {
       "type": "object",
       "required": [
@@ -656,6 +1419,25 @@ func init() {
         }
       }
     },
+    "postableAcknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Time at which the acknowledgement ends. It never expires if unset.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "postableAlert": {
       "allOf": [
         {
@@ -706,11 +1488,67 @@ func init() {
         "name"
       ],
       "properties": {
+        "integrations": {
+          "description": "State of the integrations of the receiver. Only set when circuit breakers are enabled.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationStatus"
+          },
+          "x-omitempty": true
+        },
         "name": {
           "type": "string"
         }
       }
     },
+    "receiverTestResult": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "integrations"
+      ],
+      "properties": {
+        "integrations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationTestResult"
+          }
+        },
+        "receiver": {
+          "type": "string"
+        }
+      }
+    },
+    "routeExplanation": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "muteTimeIntervals",
+        "activeTimeIntervals",
+        "muted"
+      ],
+      "properties": {
+        "activeTimeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeIntervalExplanation"
+          }
+        },
+        "muteTimeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeIntervalExplanation"
+          }
+        },
+        "muted": {
+          "description": "Whether the notifications of the route are currently muted by its time intervals.",
+          "type": "boolean"
+        },
+        "receiver": {
+          "type": "string"
+        }
+      }
+    },
     "silence": {
       "type": "object",
       "required": [
@@ -737,9 +1575,99 @@ func init() {
         "startsAt": {
           "type": "string",
           "format": "date-time"
+        },
+        "timeIntervals": {
+          "description": "Names of time intervals of the configuration. If set, the silence only mutes alerts while the current time is in one of them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "silenceExplanation": {
+      "type": "object",
+      "required": [
+        "silence",
+        "mutes"
+      ],
+      "properties": {
+        "mutes": {
+          "description": "Whether the silence mutes the alert, i.e. it is active and one of its time intervals, if it has any, contains the current time.",
+          "type": "boolean"
+        },
+        "silence": {
+          "$ref": "#/definitions/gettableSilence"
+        }
+      }
+    },
+    "silencePreview": {
+      "type": "object",
+      "required": [
+        "alerts",
+        "groups"
+      ],
+      "properties": {
+        "alerts": {
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "groups": {
+          "$ref": "#/definitions/alertGroups"
+        }
+      }
+    },
+    "silenceRevision": {
+      "type": "object",
+      "required": [
+        "action",
+        "silenceID",
+        "timestamp",
+        "matchers",
+        "startsAt",
+        "endsAt"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "expire"
+          ]
+        },
+        "author": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "endsAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "matchers": {
+          "$ref": "#/definitions/matchers"
+        },
+        "silenceID": {
+          "type": "string"
+        },
+        "startsAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
+    "silenceRevisions": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceRevision"
+      }
+    },
     "silenceStatus": {
       "type": "object",
       "required": [
@@ -756,6 +1684,22 @@ func init() {
         }
       }
     },
+    "timeIntervalExplanation": {
+      "type": "object",
+      "required": [
+        "name",
+        "contains"
+      ],
+      "properties": {
+        "contains": {
+          "description": "Whether the time interval contains the current time.",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
@@ -818,6 +1762,14 @@ func init() {
     {
       "description": "Everything related to Alertmanager alerts",
       "name": "alert"
+    },
+    {
+      "description": "Everything related to Alertmanager notifications",
+      "name": "notification"
+    },
+    {
+      "description": "Everything related to Alertmanager inhibitions",
+      "name": "inhibition"
     }
   ]
 }`))
@@ -1018,6 +1970,285 @@ func init() {
         }
       }
     },
+    "/alerts/{fingerprint}/ack": {
+      "get": {
+        "description": "Get the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertAck",
+        "responses": {
+          "200": {
+            "description": "Get acknowledgement response",
+            "schema": {
+              "$ref": "#/definitions/acknowledgement"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "The alert is not acknowledged"
+          }
+        }
+      },
+      "post": {
+        "description": "Acknowledge an alert, replacing any previous acknowledgement",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "postAlertAck",
+        "parameters": [
+          {
+            "description": "The acknowledgement to create",
+            "name": "acknowledgement",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableAcknowledgement"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Create acknowledgement response",
+            "schema": {
+              "$ref": "#/definitions/acknowledgement"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "An alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Remove the acknowledgement of an alert",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "deleteAlertAck",
+        "responses": {
+          "200": {
+            "description": "Delete acknowledgement response"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "The alert is not acknowledged"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/alerts/{fingerprint}/explain": {
+      "get": {
+        "description": "Explain why an alert is or isn't suppressed",
+        "tags": [
+          "alert"
+        ],
+        "operationId": "getAlertExplanation",
+        "responses": {
+          "200": {
+            "description": "Get alert explanation response",
+            "schema": {
+              "$ref": "#/definitions/alertExplanation"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "An alert with the specified fingerprint was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Fingerprint of the alert",
+          "name": "fingerprint",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/inhibitions": {
+      "get": {
+        "description": "Get the inhibit rules with their active source alerts and the target alerts they inhibit",
+        "tags": [
+          "inhibition"
+        ],
+        "operationId": "getInhibitions",
+        "responses": {
+          "200": {
+            "description": "Get inhibitions response",
+            "schema": {
+              "$ref": "#/definitions/inhibitions"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/notifications": {
+      "get": {
+        "description": "Get the successful notifications of the aggregation groups to each integration, most recent first. A bounded number of notifications is kept for each aggregation group and integration until the data retention time has passed",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "getNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the receiver to filter notifications by",
+            "name": "receiver",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Integration to filter notifications by, e.g. email",
+            "name": "integration",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Key of the aggregation group to filter notifications by",
+            "name": "groupKey",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return notifications sent at or after this time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return notifications sent at or before this time",
+            "name": "until",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get notifications response",
+            "schema": {
+              "$ref": "#/definitions/notifications"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/notifications/dead-letters": {
+      "get": {
+        "description": "Get the failed notifications that were given up on, oldest first",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "getDeadLetters",
+        "responses": {
+          "200": {
+            "description": "Get dead letters response",
+            "schema": {
+              "$ref": "#/definitions/deadLetters"
+            }
+          }
+        }
+      }
+    },
+    "/notifications/dead-letters/{id}/redrive": {
+      "post": {
+        "description": "Send a failed notification again through its integration. It is removed from the dead letters on success.",
+        "tags": [
+          "notification"
+        ],
+        "operationId": "redriveDeadLetter",
+        "responses": {
+          "200": {
+            "description": "Re-drive dead letter response"
+          },
+          "404": {
+            "description": "A dead letter with the specified ID was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "ID of the dead letter",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/receivers": {
       "get": {
         "description": "Get list of all receivers (name of notification integrations)",
@@ -1036,7 +2267,58 @@ func init() {
             }
           }
         }
-      }
+      }
+    },
+    "/receivers/{name}/test": {
+      "post": {
+        "description": "Send a test notification through each integration of a receiver, bypassing grouping, deduplication and the notification log.",
+        "tags": [
+          "receiver"
+        ],
+        "operationId": "testReceiver",
+        "parameters": [
+          {
+            "description": "The alerts to notify about. A firing test alert is used if none is given.",
+            "name": "alerts",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/postableAlerts"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Test receiver response",
+            "schema": {
+              "$ref": "#/definitions/receiverTestResult"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "404": {
+            "description": "A receiver with the specified name was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "Name of the receiver",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
     "/silence/{silenceID}": {
       "get": {
@@ -1077,6 +2359,12 @@ func init() {
             "name": "silenceID",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The author of the expiration, recorded in the history of the silence",
+            "name": "author",
+            "in": "query"
           }
         ],
         "responses": {
@@ -1105,6 +2393,42 @@ func init() {
         }
       ]
     },
+    "/silence/{silenceID}/history": {
+      "get": {
+        "description": "Get the changes made to a silence and to the silences it replaced, oldest first",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "getSilenceHistory",
+        "responses": {
+          "200": {
+            "description": "Get silence history response",
+            "schema": {
+              "$ref": "#/definitions/silenceRevisions"
+            }
+          },
+          "404": {
+            "description": "A silence with the specified ID was not found"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "description": "ID of the silence to get the history of",
+          "name": "silenceID",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/silences": {
       "get": {
         "description": "Get a list of silences",
@@ -1189,6 +2513,46 @@ func init() {
         }
       }
     },
+    "/silences/preview": {
+      "post": {
+        "description": "Get the current alerts a silence would mute without creating it",
+        "tags": [
+          "silence"
+        ],
+        "operationId": "previewSilence",
+        "parameters": [
+          {
+            "description": "The silence to preview",
+            "name": "silence",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postableSilence"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Silence preview response",
+            "schema": {
+              "$ref": "#/definitions/silencePreview"
+            }
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
     "/status": {
       "get": {
         "description": "Get current status of an Alertmanager instance and its cluster",
@@ -1208,6 +2572,29 @@ func init() {
     }
   },
   "definitions": {
+    "acknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy",
+        "createdAt"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "alert": {
       "type": "object",
       "required": [
@@ -1223,6 +2610,40 @@ func init() {
         }
       }
     },
+    "alertExplanation": {
+      "type": "object",
+      "required": [
+        "alert",
+        "inhibitRules",
+        "silences",
+        "routes"
+      ],
+      "properties": {
+        "alert": {
+          "$ref": "#/definitions/gettableAlert"
+        },
+        "inhibitRules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inhibitRuleExplanation"
+          }
+        },
+        "routes": {
+          "description": "Routes matching the alert.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeExplanation"
+          }
+        },
+        "silences": {
+          "description": "Active and pending silences matching the alert.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/silenceExplanation"
+          }
+        }
+      }
+    },
     "alertGroup": {
       "type": "object",
       "required": [
@@ -1259,12 +2680,22 @@ func init() {
         "inhibitedBy"
       ],
       "properties": {
+        "acknowledgement": {
+          "$ref": "#/definitions/acknowledgement"
+        },
         "inhibitedBy": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
+        "limitedBy": {
+          "description": "Receivers of the routes for which the alert was not added to an aggregation group because a limit was reached.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "silencedBy": {
           "type": "array",
           "items": {
@@ -1316,6 +2747,31 @@ func init() {
         }
       }
     },
+    "circuitBreakerStatus": {
+      "type": "object",
+      "required": [
+        "state",
+        "consecutiveFailures"
+      ],
+      "properties": {
+        "consecutiveFailures": {
+          "type": "integer"
+        },
+        "openedAt": {
+          "description": "Time the circuit breaker last opened. Not set if it is closed.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "closed",
+            "open",
+            "half-open"
+          ]
+        }
+      }
+    },
     "clusterStatus": {
       "type": "object",
       "required": [
@@ -1341,6 +2797,70 @@ func init() {
         }
       }
     },
+    "deadLetter": {
+      "type": "object",
+      "required": [
+        "id",
+        "timestamp",
+        "receiver",
+        "integration",
+        "integrationIndex",
+        "groupKey",
+        "groupLabels",
+        "fingerprints",
+        "reason",
+        "error",
+        "redrives"
+      ],
+      "properties": {
+        "error": {
+          "description": "Error of the last attempt.",
+          "type": "string"
+        },
+        "fingerprints": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupKey": {
+          "type": "string"
+        },
+        "groupLabels": {
+          "$ref": "#/definitions/labelSet"
+        },
+        "id": {
+          "type": "string"
+        },
+        "integration": {
+          "type": "string"
+        },
+        "integrationIndex": {
+          "type": "integer"
+        },
+        "reason": {
+          "description": "Failure reason of the last attempt.",
+          "type": "string"
+        },
+        "receiver": {
+          "type": "string"
+        },
+        "redrives": {
+          "description": "Number of failed attempts to re-drive the notification.",
+          "type": "integer"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "deadLetters": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/deadLetter"
+      }
+    },
     "gettableAlert": {
       "allOf": [
         {
@@ -1384,48 +2904,241 @@ func init() {
             }
           }
         },
-        {
-          "$ref": "#/definitions/alert"
+        {
+          "$ref": "#/definitions/alert"
+        }
+      ]
+    },
+    "gettableAlerts": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/gettableAlert"
+      }
+    },
+    "gettableSilence": {
+      "allOf": [
+        {
+          "type": "object",
+          "required": [
+            "id",
+            "status",
+            "updatedAt"
+          ],
+          "properties": {
+            "id": {
+              "type": "string"
+            },
+            "status": {
+              "$ref": "#/definitions/silenceStatus"
+            },
+            "updatedAt": {
+              "type": "string",
+              "format": "date-time"
+            }
+          }
+        },
+        {
+          "$ref": "#/definitions/silence"
+        }
+      ]
+    },
+    "gettableSilences": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/gettableSilence"
+      }
+    },
+    "inhibitRuleExplanation": {
+      "type": "object",
+      "required": [
+        "index",
+        "sourceMatchers",
+        "targetMatchers",
+        "equal",
+        "targetMatched",
+        "sources",
+        "inhibited"
+      ],
+      "properties": {
+        "equal": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "index": {
+          "description": "Position of the inhibit rule in the configuration.",
+          "type": "integer"
+        },
+        "inhibited": {
+          "description": "Whether the alert is inhibited by the rule.",
+          "type": "boolean"
+        },
+        "inhibitedBy": {
+          "description": "Fingerprint of the source alert inhibiting the alert.",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sources": {
+          "description": "Unresolved alerts matching the source matchers.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inhibitSourceExplanation"
+          }
+        },
+        "targetMatched": {
+          "description": "Whether the target matchers match the alert. The source alerts are only evaluated if they do.",
+          "type": "boolean"
+        },
+        "targetMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inhibitSourceExplanation": {
+      "type": "object",
+      "required": [
+        "fingerprint",
+        "labels",
+        "unequalLabels",
+        "twoSided",
+        "belowMinDuration",
+        "inhibits"
+      ],
+      "properties": {
+        "belowMinDuration": {
+          "description": "Whether the source alert is disregarded because it hasn't been firing for the minimum source duration of the rule yet.",
+          "type": "boolean"
+        },
+        "fingerprint": {
+          "type": "string"
+        },
+        "inhibits": {
+          "description": "Whether the source alert inhibits the alert.",
+          "type": "boolean"
+        },
+        "labels": {
+          "$ref": "#/definitions/labelSet"
+        },
+        "twoSided": {
+          "description": "Whether the source alert is disregarded because both alerts match the source and target matchers.",
+          "type": "boolean"
+        },
+        "unequalLabels": {
+          "description": "Equal labels of the rule whose values differ from the ones of the alert.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inhibition": {
+      "type": "object",
+      "required": [
+        "index",
+        "sourceMatchers",
+        "targetMatchers",
+        "equal",
+        "sources",
+        "targets"
+      ],
+      "properties": {
+        "equal": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "index": {
+          "description": "Position of the inhibit rule in the configuration.",
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sources": {
+          "description": "Source alerts currently inhibiting the matching target alerts.",
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "targetMatchers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "targets": {
+          "description": "Target alerts currently inhibited by the rule.",
+          "$ref": "#/definitions/gettableAlerts"
         }
-      ]
+      }
     },
-    "gettableAlerts": {
+    "inhibitions": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/gettableAlert"
+        "$ref": "#/definitions/inhibition"
       }
     },
-    "gettableSilence": {
-      "allOf": [
-        {
-          "type": "object",
-          "required": [
-            "id",
-            "status",
-            "updatedAt"
-          ],
-          "properties": {
-            "id": {
-              "type": "string"
-            },
-            "status": {
-              "$ref": "#/definitions/silenceStatus"
-            },
-            "updatedAt": {
-              "type": "string",
-              "format": "date-time"
-            }
-          }
+    "integrationStatus": {
+      "type": "object",
+      "required": [
+        "name",
+        "index"
+      ],
+      "properties": {
+        "circuitBreaker": {
+          "$ref": "#/definitions/circuitBreakerStatus"
         },
-        {
-          "$ref": "#/definitions/silence"
+        "index": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
         }
-      ]
+      }
     },
-    "gettableSilences": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/gettableSilence"
+    "integrationTestResult": {
+      "type": "object",
+      "required": [
+        "name",
+        "index",
+        "success",
+        "latencySeconds"
+      ],
+      "properties": {
+        "error": {
+          "description": "The error returned by the integration if the notification failed.",
+          "type": "string"
+        },
+        "index": {
+          "type": "integer"
+        },
+        "latencySeconds": {
+          "type": "number"
+        },
+        "name": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
       }
     },
     "labelSet": {
@@ -1464,6 +3177,56 @@ func init() {
         "$ref": "#/definitions/matcher"
       }
     },
+    "notification": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "integration",
+        "integrationIndex",
+        "groupKey",
+        "timestamp",
+        "firingAlerts",
+        "resolvedAlerts"
+      ],
+      "properties": {
+        "firingAlerts": {
+          "description": "Hashes of the labels of the alerts that were firing at notification time.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupKey": {
+          "type": "string"
+        },
+        "integration": {
+          "type": "string"
+        },
+        "integrationIndex": {
+          "type": "integer"
+        },
+        "receiver": {
+          "type": "string"
+        },
+        "resolvedAlerts": {
+          "description": "Hashes of the labels of the alerts that were resolved at notification time.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "notifications": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/notification"
+      }
+    },
     "peerStatus": {
       "type": "object",
       "required": [
@@ -1479,6 +3242,25 @@ func init() {
         }
       }
     },
+    "postableAcknowledgement": {
+      "type": "object",
+      "required": [
+        "acknowledgedBy"
+      ],
+      "properties": {
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "Time at which the acknowledgement ends. It never expires if unset.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "postableAlert": {
       "allOf": [
         {
@@ -1529,11 +3311,67 @@ func init() {
         "name"
       ],
       "properties": {
+        "integrations": {
+          "description": "State of the integrations of the receiver. Only set when circuit breakers are enabled.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationStatus"
+          },
+          "x-omitempty": true
+        },
         "name": {
           "type": "string"
         }
       }
     },
+    "receiverTestResult": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "integrations"
+      ],
+      "properties": {
+        "integrations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationTestResult"
+          }
+        },
+        "receiver": {
+          "type": "string"
+        }
+      }
+    },
+    "routeExplanation": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "muteTimeIntervals",
+        "activeTimeIntervals",
+        "muted"
+      ],
+      "properties": {
+        "activeTimeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeIntervalExplanation"
+          }
+        },
+        "muteTimeIntervals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/timeIntervalExplanation"
+          }
+        },
+        "muted": {
+          "description": "Whether the notifications of the route are currently muted by its time intervals.",
+          "type": "boolean"
+        },
+        "receiver": {
+          "type": "string"
+        }
+      }
+    },
     "silence": {
       "type": "object",
       "required": [
@@ -1560,9 +3398,99 @@ func init() {
         "startsAt": {
           "type": "string",
           "format": "date-time"
+        },
+        "timeIntervals": {
+          "description": "Names of time intervals of the configuration. If set, the silence only mutes alerts while the current time is in one of them.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "silenceExplanation": {
+      "type": "object",
+      "required": [
+        "silence",
+        "mutes"
+      ],
+      "properties": {
+        "mutes": {
+          "description": "Whether the silence mutes the alert, i.e. it is active and one of its time intervals, if it has any, contains the current time.",
+          "type": "boolean"
+        },
+        "silence": {
+          "$ref": "#/definitions/gettableSilence"
+        }
+      }
+    },
+    "silencePreview": {
+      "type": "object",
+      "required": [
+        "alerts",
+        "groups"
+      ],
+      "properties": {
+        "alerts": {
+          "$ref": "#/definitions/gettableAlerts"
+        },
+        "groups": {
+          "$ref": "#/definitions/alertGroups"
+        }
+      }
+    },
+    "silenceRevision": {
+      "type": "object",
+      "required": [
+        "action",
+        "silenceID",
+        "timestamp",
+        "matchers",
+        "startsAt",
+        "endsAt"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "expire"
+          ]
+        },
+        "author": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "endsAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "matchers": {
+          "$ref": "#/definitions/matchers"
+        },
+        "silenceID": {
+          "type": "string"
+        },
+        "startsAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
+    "silenceRevisions": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/silenceRevision"
+      }
+    },
     "silenceStatus": {
       "type": "object",
       "required": [
@@ -1579,6 +3507,22 @@ func init() {
         }
       }
     },
+    "timeIntervalExplanation": {
+      "type": "object",
+      "required": [
+        "name",
+        "contains"
+      ],
+      "properties": {
+        "contains": {
+          "description": "Whether the time interval contains the current time.",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
@@ -1641,6 +3585,14 @@ func init() {
     {
       "description": "Everything related to Alertmanager alerts",
       "name": "alert"
+    },
+    {
+      "description": "Everything related to Alertmanager notifications",
+      "name": "notification"
+    },
+    {
+      "description": "Everything related to Alertmanager inhibitions",
+      "name": "inhibition"
     }
   ]
 }`))
@@ -36,6 +36,8 @@ import (
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/alert"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/alertgroup"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
+	"github.com/prometheus/alertmanager/api/v2/restapi/operations/inhibition"
+	"github.com/prometheus/alertmanager/api/v2/restapi/operations/notification"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	"github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
 )
@@ -62,33 +64,66 @@ func NewAlertmanagerAPI(spec *loads.Document) *AlertmanagerAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		AlertDeleteAlertAckHandler: alert.DeleteAlertAckHandlerFunc(func(params alert.DeleteAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertAck has not yet been implemented")
+		}),
 		SilenceDeleteSilenceHandler: silence.DeleteSilenceHandlerFunc(func(params silence.DeleteSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.DeleteSilence has not yet been implemented")
 		}),
+		AlertGetAlertAckHandler: alert.GetAlertAckHandlerFunc(func(params alert.GetAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertAck has not yet been implemented")
+		}),
+		AlertGetAlertExplanationHandler: alert.GetAlertExplanationHandlerFunc(func(params alert.GetAlertExplanationParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.GetAlertExplanation has not yet been implemented")
+		}),
 		AlertgroupGetAlertGroupsHandler: alertgroup.GetAlertGroupsHandlerFunc(func(params alertgroup.GetAlertGroupsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alertgroup.GetAlertGroups has not yet been implemented")
 		}),
 		AlertGetAlertsHandler: alert.GetAlertsHandlerFunc(func(params alert.GetAlertsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alert.GetAlerts has not yet been implemented")
 		}),
+		NotificationGetDeadLettersHandler: notification.GetDeadLettersHandlerFunc(func(params notification.GetDeadLettersParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.GetDeadLetters has not yet been implemented")
+		}),
+		InhibitionGetInhibitionsHandler: inhibition.GetInhibitionsHandlerFunc(func(params inhibition.GetInhibitionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation inhibition.GetInhibitions has not yet been implemented")
+		}),
+		NotificationGetNotificationsHandler: notification.GetNotificationsHandlerFunc(func(params notification.GetNotificationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.GetNotifications has not yet been implemented")
+		}),
 		ReceiverGetReceiversHandler: receiver.GetReceiversHandlerFunc(func(params receiver.GetReceiversParams) middleware.Responder {
 			return middleware.NotImplemented("operation receiver.GetReceivers has not yet been implemented")
 		}),
 		SilenceGetSilenceHandler: silence.GetSilenceHandlerFunc(func(params silence.GetSilenceParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilence has not yet been implemented")
 		}),
+		SilenceGetSilenceHistoryHandler: silence.GetSilenceHistoryHandlerFunc(func(params silence.GetSilenceHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.GetSilenceHistory has not yet been implemented")
+		}),
 		SilenceGetSilencesHandler: silence.GetSilencesHandlerFunc(func(params silence.GetSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.GetSilences has not yet been implemented")
 		}),
 		GeneralGetStatusHandler: general.GetStatusHandlerFunc(func(params general.GetStatusParams) middleware.Responder {
 			return middleware.NotImplemented("operation general.GetStatus has not yet been implemented")
 		}),
+		AlertPostAlertAckHandler: alert.PostAlertAckHandlerFunc(func(params alert.PostAlertAckParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.PostAlertAck has not yet been implemented")
+		}),
 		AlertPostAlertsHandler: alert.PostAlertsHandlerFunc(func(params alert.PostAlertsParams) middleware.Responder {
 			return middleware.NotImplemented("operation alert.PostAlerts has not yet been implemented")
 		}),
 		SilencePostSilencesHandler: silence.PostSilencesHandlerFunc(func(params silence.PostSilencesParams) middleware.Responder {
 			return middleware.NotImplemented("operation silence.PostSilences has not yet been implemented")
 		}),
+		SilencePreviewSilenceHandler: silence.PreviewSilenceHandlerFunc(func(params silence.PreviewSilenceParams) middleware.Responder {
+			return middleware.NotImplemented("operation silence.PreviewSilence has not yet been implemented")
+		}),
+		NotificationRedriveDeadLetterHandler: notification.RedriveDeadLetterHandlerFunc(func(params notification.RedriveDeadLetterParams) middleware.Responder {
+			return middleware.NotImplemented("operation notification.RedriveDeadLetter has not yet been implemented")
+		}),
+		ReceiverTestReceiverHandler: receiver.TestReceiverHandlerFunc(func(params receiver.TestReceiverParams) middleware.Responder {
+			return middleware.NotImplemented("operation receiver.TestReceiver has not yet been implemented")
+		}),
 	}
 }
 
@@ -125,24 +160,46 @@ type AlertmanagerAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// AlertDeleteAlertAckHandler sets the operation handler for the delete alert ack operation
+	AlertDeleteAlertAckHandler alert.DeleteAlertAckHandler
 	// SilenceDeleteSilenceHandler sets the operation handler for the delete silence operation
 	SilenceDeleteSilenceHandler silence.DeleteSilenceHandler
+	// AlertGetAlertAckHandler sets the operation handler for the get alert ack operation
+	AlertGetAlertAckHandler alert.GetAlertAckHandler
+	// AlertGetAlertExplanationHandler sets the operation handler for the get alert explanation operation
+	AlertGetAlertExplanationHandler alert.GetAlertExplanationHandler
 	// AlertgroupGetAlertGroupsHandler sets the operation handler for the get alert groups operation
 	AlertgroupGetAlertGroupsHandler alertgroup.GetAlertGroupsHandler
 	// AlertGetAlertsHandler sets the operation handler for the get alerts operation
 	AlertGetAlertsHandler alert.GetAlertsHandler
+	// NotificationGetDeadLettersHandler sets the operation handler for the get dead letters operation
+	NotificationGetDeadLettersHandler notification.GetDeadLettersHandler
+	// InhibitionGetInhibitionsHandler sets the operation handler for the get inhibitions operation
+	InhibitionGetInhibitionsHandler inhibition.GetInhibitionsHandler
+	// NotificationGetNotificationsHandler sets the operation handler for the get notifications operation
+	NotificationGetNotificationsHandler notification.GetNotificationsHandler
 	// ReceiverGetReceiversHandler sets the operation handler for the get receivers operation
 	ReceiverGetReceiversHandler receiver.GetReceiversHandler
 	// SilenceGetSilenceHandler sets the operation handler for the get silence operation
 	SilenceGetSilenceHandler silence.GetSilenceHandler
+	// SilenceGetSilenceHistoryHandler sets the operation handler for the get silence history operation
+	SilenceGetSilenceHistoryHandler silence.GetSilenceHistoryHandler
 	// SilenceGetSilencesHandler sets the operation handler for the get silences operation
 	SilenceGetSilencesHandler silence.GetSilencesHandler
 	// GeneralGetStatusHandler sets the operation handler for the get status operation
 	GeneralGetStatusHandler general.GetStatusHandler
+	// AlertPostAlertAckHandler sets the operation handler for the post alert ack operation
+	AlertPostAlertAckHandler alert.PostAlertAckHandler
 	// AlertPostAlertsHandler sets the operation handler for the post alerts operation
 	AlertPostAlertsHandler alert.PostAlertsHandler
 	// SilencePostSilencesHandler sets the operation handler for the post silences operation
 	SilencePostSilencesHandler silence.PostSilencesHandler
+	// SilencePreviewSilenceHandler sets the operation handler for the preview silence operation
+	SilencePreviewSilenceHandler silence.PreviewSilenceHandler
+	// NotificationRedriveDeadLetterHandler sets the operation handler for the redrive dead letter operation
+	NotificationRedriveDeadLetterHandler notification.RedriveDeadLetterHandler
+	// ReceiverTestReceiverHandler sets the operation handler for the test receiver operation
+	ReceiverTestReceiverHandler receiver.TestReceiverHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -220,33 +277,66 @@ func (o *AlertmanagerAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.AlertDeleteAlertAckHandler == nil {
+		unregistered = append(unregistered, "alert.DeleteAlertAckHandler")
+	}
 	if o.SilenceDeleteSilenceHandler == nil {
 		unregistered = append(unregistered, "silence.DeleteSilenceHandler")
 	}
+	if o.AlertGetAlertAckHandler == nil {
+		unregistered = append(unregistered, "alert.GetAlertAckHandler")
+	}
+	if o.AlertGetAlertExplanationHandler == nil {
+		unregistered = append(unregistered, "alert.GetAlertExplanationHandler")
+	}
 	if o.AlertgroupGetAlertGroupsHandler == nil {
 		unregistered = append(unregistered, "alertgroup.GetAlertGroupsHandler")
 	}
 	if o.AlertGetAlertsHandler == nil {
 		unregistered = append(unregistered, "alert.GetAlertsHandler")
 	}
+	if o.NotificationGetDeadLettersHandler == nil {
+		unregistered = append(unregistered, "notification.GetDeadLettersHandler")
+	}
+	if o.InhibitionGetInhibitionsHandler == nil {
+		unregistered = append(unregistered, "inhibition.GetInhibitionsHandler")
+	}
+	if o.NotificationGetNotificationsHandler == nil {
+		unregistered = append(unregistered, "notification.GetNotificationsHandler")
+	}
 	if o.ReceiverGetReceiversHandler == nil {
 		unregistered = append(unregistered, "receiver.GetReceiversHandler")
 	}
 	if o.SilenceGetSilenceHandler == nil {
 		unregistered = append(unregistered, "silence.GetSilenceHandler")
 	}
+	if o.SilenceGetSilenceHistoryHandler == nil {
+		unregistered = append(unregistered, "silence.GetSilenceHistoryHandler")
+	}
 	if o.SilenceGetSilencesHandler == nil {
 		unregistered = append(unregistered, "silence.GetSilencesHandler")
 	}
 	if o.GeneralGetStatusHandler == nil {
 		unregistered = append(unregistered, "general.GetStatusHandler")
 	}
+	if o.AlertPostAlertAckHandler == nil {
+		unregistered = append(unregistered, "alert.PostAlertAckHandler")
+	}
 	if o.AlertPostAlertsHandler == nil {
 		unregistered = append(unregistered, "alert.PostAlertsHandler")
 	}
 	if o.SilencePostSilencesHandler == nil {
 		unregistered = append(unregistered, "silence.PostSilencesHandler")
 	}
+	if o.SilencePreviewSilenceHandler == nil {
+		unregistered = append(unregistered, "silence.PreviewSilenceHandler")
+	}
+	if o.NotificationRedriveDeadLetterHandler == nil {
+		unregistered = append(unregistered, "notification.RedriveDeadLetterHandler")
+	}
+	if o.ReceiverTestReceiverHandler == nil {
+		unregistered = append(unregistered, "receiver.TestReceiverHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -335,6 +425,10 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/alerts/{fingerprint}/ack"] = alert.NewDeleteAlertAck(o.context, o.AlertDeleteAlertAckHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -342,6 +436,14 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/alerts/{fingerprint}/ack"] = alert.NewGetAlertAck(o.context, o.AlertGetAlertAckHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/alerts/{fingerprint}/explain"] = alert.NewGetAlertExplanation(o.context, o.AlertGetAlertExplanationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/alerts/groups"] = alertgroup.NewGetAlertGroups(o.context, o.AlertgroupGetAlertGroupsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -350,6 +452,18 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/notifications/dead-letters"] = notification.NewGetDeadLetters(o.context, o.NotificationGetDeadLettersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/inhibitions"] = inhibition.NewGetInhibitions(o.context, o.InhibitionGetInhibitionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/notifications"] = notification.NewGetNotifications(o.context, o.NotificationGetNotificationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/receivers"] = receiver.NewGetReceivers(o.context, o.ReceiverGetReceiversHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -358,6 +472,10 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/silence/{silenceID}/history"] = silence.NewGetSilenceHistory(o.context, o.SilenceGetSilenceHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/silences"] = silence.NewGetSilences(o.context, o.SilenceGetSilencesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -366,11 +484,27 @@ func (o *AlertmanagerAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/alerts/{fingerprint}/ack"] = alert.NewPostAlertAck(o.context, o.AlertPostAlertAckHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/alerts"] = alert.NewPostAlerts(o.context, o.AlertPostAlertsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/silences"] = silence.NewPostSilences(o.context, o.SilencePostSilencesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/silences/preview"] = silence.NewPreviewSilence(o.context, o.SilencePreviewSilenceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/notifications/dead-letters/{id}/redrive"] = notification.NewRedriveDeadLetter(o.context, o.NotificationRedriveDeadLetterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/receivers/{name}/test"] = receiver.NewTestReceiver(o.context, o.ReceiverTestReceiverHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -23,6 +23,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
@@ -45,6 +46,10 @@ type DeleteSilenceParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The author of the expiration, recorded in the history of the silence
+	  In: query
+	*/
+	Author *string
 	/*ID of the silence to get
 	  Required: true
 	  In: path
@@ -61,6 +66,13 @@ func (o *DeleteSilenceParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qAuthor, qhkAuthor, _ := qs.GetOK("author")
+	if err := o.bindAuthor(qAuthor, qhkAuthor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rSilenceID, rhkSilenceID, _ := route.Params.GetOK("silenceID")
 	if err := o.bindSilenceID(rSilenceID, rhkSilenceID, route.Formats); err != nil {
 		res = append(res, err)
@@ -71,6 +83,24 @@ func (o *DeleteSilenceParams) BindRequest(r *http.Request, route *middleware.Mat
 	return nil
 }
 
+// bindAuthor binds and validates parameter Author from query.
+func (o *DeleteSilenceParams) bindAuthor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Author = &raw
+
+	return nil
+}
+
 // bindSilenceID binds and validates parameter SilenceID from path.
 func (o *DeleteSilenceParams) bindSilenceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -32,6 +32,8 @@ import (
 type DeleteSilenceURL struct {
 	SilenceID strfmt.UUID
 
+	Author *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -71,6 +73,18 @@ func (o *DeleteSilenceURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var authorQ string
+	if o.Author != nil {
+		authorQ = *o.Author
+	}
+	if authorQ != "" {
+		qs.Set("author", authorQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,287 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+	"time"
+	"unicode"
+
+	prometheus_model "github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// checkSilencePolicy returns the reason why the silence violates the silence
+// policy of the configuration, nil if it complies with it.
+func (api *API) checkSilencePolicy(sil *silencepb.Silence, now time.Time) error {
+	api.mtx.RLock()
+	if api.alertmanagerConfig == nil || api.alertmanagerConfig.SilencePolicy == nil {
+		api.mtx.RUnlock()
+		return nil
+	}
+	p := *api.alertmanagerConfig.SilencePolicy
+	api.mtx.RUnlock()
+
+	if p.MaxDuration > 0 {
+		start := sil.StartsAt
+		if start.Before(now) {
+			start = now
+		}
+		if d := sil.EndsAt.Sub(start); d > time.Duration(p.MaxDuration) {
+			return fmt.Errorf("silence duration %s exceeds the maximum of %s", prometheus_model.Duration(d), p.MaxDuration)
+		}
+	}
+
+	if p.ForbidMatchAll {
+		for _, m := range sil.Matchers {
+			if matchesAll(m) {
+				return fmt.Errorf("matcher on label %q matches any value", m.Name)
+			}
+		}
+	}
+
+	for _, ln := range p.RequiredLabels {
+		found := false
+		for _, m := range sil.Matchers {
+			if m.Name == string(ln) && !matchesAll(m) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("silence must have a matcher on label %q", ln)
+		}
+	}
+
+	if p.CommentPattern != nil && !p.CommentPattern.MatchString(sil.Comment) {
+		return fmt.Errorf("comment doesn't match the pattern %s", p.CommentPattern.String())
+	}
+
+	if p.MaxSilencesPerCreator > 0 {
+		sils, _, err := api.silences.Query(silence.QState(types.SilenceStateActive, types.SilenceStatePending))
+		if err != nil {
+			return fmt.Errorf("count silences of %q: %w", sil.CreatedBy, err)
+		}
+		n := 0
+		for _, s := range sils {
+			// The silence being updated doesn't count.
+			if s.CreatedBy == sil.CreatedBy && s.Id != sil.Id {
+				n++
+			}
+		}
+		if n >= p.MaxSilencesPerCreator {
+			return fmt.Errorf("%q already has %d active or pending silences, the maximum is %d", sil.CreatedBy, n, p.MaxSilencesPerCreator)
+		}
+	}
+
+	return nil
+}
+
+// matchesAll returns whether the matcher matches any non-empty value, such as
+// =~".*", =~".+" or !~"".
+func matchesAll(m *silencepb.Matcher) bool {
+	switch m.Type {
+	case silencepb.Matcher_NOT_EQUAL:
+		return m.Pattern == ""
+	case silencepb.Matcher_REGEXP:
+		return matchesAnyValue(m.Pattern)
+	case silencepb.Matcher_NOT_REGEXP:
+		return matchesOnlyEmpty(m.Pattern)
+	}
+	return false
+}
+
+// maxMatchAllStates bounds the sets of states of the program explored by
+// matchesAnyValue. Patterns requiring more are not considered to match any
+// value.
+const maxMatchAllStates = 256
+
+// matchesAnyValue returns whether the regular expression, anchored like the
+// matchers of silences, matches every non-empty string without newlines. The
+// compiled program is run on all the strings at once: it must accept any rune
+// at every step, and be able to match after each of them.
+func matchesAnyValue(pattern string) bool {
+	re, err := syntax.Parse("^(?:"+pattern+")$", syntax.Perl)
+	if err != nil {
+		return false
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return false
+	}
+	runes, ok := programRunes(prog)
+	if !ok {
+		return false
+	}
+
+	start, ok := closure(prog, []uint32{uint32(prog.Start)}, true, false)
+	if !ok {
+		return false
+	}
+	seen := map[string]struct{}{fmt.Sprint(start): {}}
+	for queue := [][]uint32{start}; len(queue) > 0; queue = queue[1:] {
+		for _, r := range runes {
+			var next []uint32
+			for _, pc := range queue[0] {
+				if inst := &prog.Inst[pc]; consumes(inst, r) {
+					next = append(next, inst.Out)
+				}
+			}
+			// The string ending with the rune must match.
+			end, ok := closure(prog, next, false, true)
+			if !ok || !hasMatch(prog, end) {
+				return false
+			}
+			mid, ok := closure(prog, next, false, false)
+			if !ok {
+				return false
+			}
+			k := fmt.Sprint(mid)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			if len(seen) >= maxMatchAllStates {
+				return false
+			}
+			seen[k] = struct{}{}
+			queue = append(queue, mid)
+		}
+	}
+	return true
+}
+
+// programRunes returns a rune of each range of runes which the instructions of
+// the program can't tell apart, newlines and surrogates excluded. It returns
+// false if the program has word boundaries, which depend on the neighboring
+// runes.
+func programRunes(prog *syntax.Prog) ([]rune, bool) {
+	bounds := map[rune]struct{}{0: {}, '\n': {}, '\n' + 1: {}, 0xD800: {}, 0xE000: {}}
+	for _, inst := range prog.Inst {
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1:
+			if len(inst.Rune) == 1 {
+				// Single runes may match their other cases.
+				for r := inst.Rune[0]; ; {
+					bounds[r], bounds[r+1] = struct{}{}, struct{}{}
+					if r = unicode.SimpleFold(r); r == inst.Rune[0] {
+						break
+					}
+				}
+				continue
+			}
+			for i := 0; i+1 < len(inst.Rune); i += 2 {
+				bounds[inst.Rune[i]], bounds[inst.Rune[i+1]+1] = struct{}{}, struct{}{}
+			}
+		case syntax.InstEmptyWidth:
+			if syntax.EmptyOp(inst.Arg)&(syntax.EmptyWordBoundary|syntax.EmptyNoWordBoundary) != 0 {
+				return nil, false
+			}
+		}
+	}
+	runes := make([]rune, 0, len(bounds))
+	for r := range bounds {
+		if r != '\n' && r <= unicode.MaxRune && (r < 0xD800 || r >= 0xE000) {
+			runes = append(runes, r)
+		}
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes, true
+}
+
+// consumes returns whether the instruction consumes the rune.
+func consumes(inst *syntax.Inst, r rune) bool {
+	switch inst.Op {
+	case syntax.InstRune, syntax.InstRune1:
+		return inst.MatchRune(r)
+	case syntax.InstRuneAny:
+		return true
+	case syntax.InstRuneAnyNotNL:
+		return r != '\n'
+	}
+	return false
+}
+
+// closure returns the sorted instructions reachable from the given ones
+// without consuming a rune, at the beginning and at the end of the string or
+// not. It returns false if an empty-width assertion can't be evaluated.
+func closure(prog *syntax.Prog, pcs []uint32, begin, end bool) ([]uint32, bool) {
+	seen := map[uint32]struct{}{}
+	var res []uint32
+	for len(pcs) > 0 {
+		pc := pcs[len(pcs)-1]
+		pcs = pcs[:len(pcs)-1]
+		if _, ok := seen[pc]; ok {
+			continue
+		}
+		seen[pc] = struct{}{}
+
+		inst := &prog.Inst[pc]
+		switch inst.Op {
+		case syntax.InstAlt, syntax.InstAltMatch:
+			pcs = append(pcs, inst.Out, inst.Arg)
+		case syntax.InstCapture, syntax.InstNop:
+			pcs = append(pcs, inst.Out)
+		case syntax.InstEmptyWidth:
+			op := syntax.EmptyOp(inst.Arg)
+			if op&(syntax.EmptyWordBoundary|syntax.EmptyNoWordBoundary) != 0 {
+				return nil, false
+			}
+			if (op&(syntax.EmptyBeginLine|syntax.EmptyBeginText) != 0 && !begin) ||
+				(op&(syntax.EmptyEndLine|syntax.EmptyEndText) != 0 && !end) {
+				continue
+			}
+			pcs = append(pcs, inst.Out)
+		case syntax.InstFail:
+		default:
+			res = append(res, pc)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, true
+}
+
+// hasMatch returns whether one of the instructions is a match.
+func hasMatch(prog *syntax.Prog, pcs []uint32) bool {
+	for _, pc := range pcs {
+		if prog.Inst[pc].Op == syntax.InstMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesOnlyEmpty returns whether the regular expression can't match any
+// non-empty string, i.e. it has no instruction consuming a character.
+func matchesOnlyEmpty(pattern string) bool {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return false
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return false
+	}
+	for _, inst := range prog.Inst {
+		switch inst.Op {
+		case syntax.InstRune, syntax.InstRune1, syntax.InstRuneAny, syntax.InstRuneAnyNotNL:
+			return false
+		}
+	}
+	return true
+}
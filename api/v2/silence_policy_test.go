@@ -0,0 +1,177 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/require"
+
+	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+func TestCheckSilencePolicy(t *testing.T) {
+	now := time.Now()
+
+	cfg, err := config.Load(`
+route:
+  receiver: team-X
+receivers:
+- name: team-X
+silence_policy:
+  max_duration: 1d
+  required_labels: [team]
+  forbid_match_all: true
+  max_silences_per_creator: 1
+  comment_pattern: '.*[A-Z]+-[0-9]+.*'
+`)
+	require.NoError(t, err)
+
+	silences := newSilences(t)
+	api := API{
+		uptime:             time.Now(),
+		silences:           silences,
+		alertmanagerConfig: cfg,
+		logger:             log.NewNopLogger(),
+	}
+
+	newSilence := func(creator, comment string, d time.Duration, matchers ...*silencepb.Matcher) *silencepb.Silence {
+		return &silencepb.Silence{
+			Matchers:  matchers,
+			StartsAt:  now,
+			EndsAt:    now.Add(d),
+			CreatedBy: creator,
+			Comment:   comment,
+		}
+	}
+	team := &silencepb.Matcher{Type: silencepb.Matcher_EQUAL, Name: "team", Pattern: "db"}
+
+	for _, tc := range []struct {
+		name string
+		sil  *silencepb.Silence
+		err  string
+	}{
+		{
+			name: "valid",
+			sil:  newSilence("alice", "see OPS-123", time.Hour, team),
+		},
+		{
+			name: "too long",
+			sil:  newSilence("alice", "see OPS-123", 2*24*time.Hour, team),
+			err:  "silence duration 2d exceeds the maximum of 1d",
+		},
+		{
+			name: "missing required label",
+			sil: newSilence("alice", "see OPS-123", time.Hour,
+				&silencepb.Matcher{Type: silencepb.Matcher_EQUAL, Name: "alertname", Pattern: "foo"},
+			),
+			err: `silence must have a matcher on label "team"`,
+		},
+		{
+			name: "match-all matcher",
+			sil: newSilence("alice", "see OPS-123", time.Hour, team,
+				&silencepb.Matcher{Type: silencepb.Matcher_REGEXP, Name: "instance", Pattern: "(.*)"},
+			),
+			err: `matcher on label "instance" matches any value`,
+		},
+		{
+			name: "comment without ticket",
+			sil:  newSilence("alice", "maintenance", time.Hour, team),
+			err:  "comment doesn't match the pattern ^(?:.*[A-Z]+-[0-9]+.*)$",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := api.checkSilencePolicy(tc.sil, now)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+
+	// Updating an existing silence doesn't count against the creator.
+	sil := newSilence("alice", "see OPS-123", time.Hour, team)
+	sid, err := silences.Set(sil)
+	require.NoError(t, err)
+	require.NoError(t, api.checkSilencePolicy(newSilence("bob", "see OPS-123", time.Hour, team), now))
+	update := newSilence("alice", "see OPS-124", time.Hour, team)
+	update.Id = sid
+	require.NoError(t, api.checkSilencePolicy(update, now))
+	require.EqualError(t,
+		api.checkSilencePolicy(newSilence("alice", "see OPS-125", time.Hour, team), now),
+		`"alice" already has 1 active or pending silences, the maximum is 1`,
+	)
+
+	// Violations are rejected by the API.
+	apiSil, b := createSilence(t, "", "carol", now.Add(time.Hour), now.Add(2*time.Hour))
+	r, err := http.NewRequest("POST", "/api/v2/silences", bytes.NewReader(b))
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.postSilencesHandler(silence_ops.PostSilencesParams{
+		HTTPRequest: r,
+		Silence:     &apiSil,
+	}).WriteResponse(w, runtime.TextProducer())
+	body, _ := io.ReadAll(w.Result().Body)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, `Failed to create silence: silence policy violated: silence must have a matcher on label "team"`, string(body))
+}
+
+func TestMatchesAll(t *testing.T) {
+	for _, tc := range []struct {
+		typ      silencepb.Matcher_Type
+		pattern  string
+		expected bool
+	}{
+		{silencepb.Matcher_REGEXP, ".*", true},
+		{silencepb.Matcher_REGEXP, "(.*)", true},
+		{silencepb.Matcher_REGEXP, "(?s).*", true},
+		{silencepb.Matcher_REGEXP, ".+", true},
+		{silencepb.Matcher_REGEXP, ".+|", true},
+		{silencepb.Matcher_REGEXP, ".*|foo", true},
+		{silencepb.Matcher_REGEXP, "[^\\n]*", true},
+		{silencepb.Matcher_REGEXP, "^.*$", true},
+		{silencepb.Matcher_REGEXP, "(?s).+", true},
+		{silencepb.Matcher_REGEXP, "a.*|[^a].*", true},
+		{silencepb.Matcher_REGEXP, "(?i)a.*|[^a].*", true},
+		{silencepb.Matcher_REGEXP, ".+.+", false},
+		{silencepb.Matcher_REGEXP, "[^a]*", false},
+		{silencepb.Matcher_REGEXP, "a.*|[^b].*", false},
+		{silencepb.Matcher_REGEXP, "\\b.*", false},
+		{silencepb.Matcher_REGEXP, "foo.*", false},
+		{silencepb.Matcher_REGEXP, "[a-z]+", false},
+		{silencepb.Matcher_REGEXP, "", false},
+		{silencepb.Matcher_REGEXP, "(", false},
+		{silencepb.Matcher_NOT_REGEXP, "", true},
+		{silencepb.Matcher_NOT_REGEXP, "^$", true},
+		{silencepb.Matcher_NOT_REGEXP, "()", true},
+		{silencepb.Matcher_NOT_REGEXP, "foo", false},
+		{silencepb.Matcher_NOT_REGEXP, ".*", false},
+		{silencepb.Matcher_NOT_EQUAL, "", true},
+		{silencepb.Matcher_NOT_EQUAL, "foo", false},
+		{silencepb.Matcher_EQUAL, ".*", false},
+		{silencepb.Matcher_EQUAL, "", false},
+	} {
+		require.Equal(t, tc.expected, matchesAll(&silencepb.Matcher{Type: tc.typ, Pattern: tc.pattern}), "%s %q", tc.typ, tc.pattern)
+	}
+}
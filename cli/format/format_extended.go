@@ -41,11 +41,11 @@ func (formatter *ExtendedFormatter) SetOutput(writer io.Writer) {
 func (formatter *ExtendedFormatter) FormatSilences(silences []models.GettableSilence) error {
 	w := tabwriter.NewWriter(formatter.writer, 0, 0, 2, ' ', 0)
 	sort.Sort(ByEndAt(silences))
-	fmt.Fprintln(w, "ID\tMatchers\tStarts At\tEnds At\tUpdated At\tCreated By\tComment\t")
+	fmt.Fprintln(w, "ID\tMatchers\tStarts At\tEnds At\tUpdated At\tCreated By\tComment\tTime Intervals\t")
 	for _, silence := range silences {
 		fmt.Fprintf(
 			w,
-			"%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
 			*silence.ID,
 			extendedFormatMatchers(silence.Matchers),
 			FormatDate(*silence.Silence.StartsAt),
@@ -53,6 +53,7 @@ func (formatter *ExtendedFormatter) FormatSilences(silences []models.GettableSil
 			FormatDate(*silence.UpdatedAt),
 			*silence.CreatedBy,
 			*silence.Comment,
+			strings.Join(silence.TimeIntervals, ","),
 		)
 	}
 	return w.Flush()
@@ -27,6 +27,7 @@ import (
 
 	"github.com/prometheus/alertmanager/api/v2/client/silence"
 	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/cli/format"
 	"github.com/prometheus/alertmanager/matchers/compat"
 	"github.com/prometheus/alertmanager/pkg/labels"
 )
@@ -46,6 +47,8 @@ type silenceAddCmd struct {
 	start          string
 	end            string
 	comment        string
+	timeIntervals  []string
+	dryRun         bool
 	matchers       []string
 }
 
@@ -71,6 +74,15 @@ const silenceAddHelp = `Add a new alertmanager silence
 	As well as direct equality, regex matching is also supported. The '=~' syntax
 	(similar to Prometheus) is used to represent a regex match. Regex matching
 	can be used in combination with a direct match.
+
+  amtool silence add --time-interval=weekends alertname=foo
+
+	The silence only mutes the alerts while the current time is in one of
+	the given time intervals of the Alertmanager configuration.
+
+  amtool silence add --dry-run alertname=foo
+
+	Print the alerts the silence would currently mute without creating it.
 `
 
 func configureSilenceAddCmd(cc *kingpin.CmdClause) {
@@ -84,6 +96,8 @@ func configureSilenceAddCmd(cc *kingpin.CmdClause) {
 	addCmd.Flag("start", "Set when the silence should start. RFC3339 format 2006-01-02T15:04:05-07:00").StringVar(&c.start)
 	addCmd.Flag("end", "Set when the silence should end (overwrites duration). RFC3339 format 2006-01-02T15:04:05-07:00").StringVar(&c.end)
 	addCmd.Flag("comment", "A comment to help describe the silence").Short('c').StringVar(&c.comment)
+	addCmd.Flag("time-interval", "Name of a time interval of the Alertmanager configuration outside of which the silence doesn't mute alerts, can be repeated").StringsVar(&c.timeIntervals)
+	addCmd.Flag("dry-run", "Print the alerts the silence would mute without creating it").BoolVar(&c.dryRun)
 	addCmd.Arg("matcher-groups", "Query filter").StringsVar(&c.matchers)
 	addCmd.Action(execWithTimeout(c.add))
 }
@@ -145,7 +159,7 @@ func (c *silenceAddCmd) add(ctx context.Context, _ *kingpin.ParseContext) error
 		return errors.New("silence cannot start after it ends")
 	}
 
-	if c.requireComment && c.comment == "" {
+	if c.requireComment && c.comment == "" && !c.dryRun {
 		return errors.New("comment required by config")
 	}
 
@@ -153,18 +167,33 @@ func (c *silenceAddCmd) add(ctx context.Context, _ *kingpin.ParseContext) error
 	end := strfmt.DateTime(endsAt)
 	ps := &models.PostableSilence{
 		Silence: models.Silence{
-			Matchers:  TypeMatchers(matchers),
-			StartsAt:  &start,
-			EndsAt:    &end,
-			CreatedBy: &c.author,
-			Comment:   &c.comment,
+			Matchers:      TypeMatchers(matchers),
+			StartsAt:      &start,
+			EndsAt:        &end,
+			CreatedBy:     &c.author,
+			Comment:       &c.comment,
+			TimeIntervals: c.timeIntervals,
 		},
 	}
+	amclient := NewAlertmanagerClient(alertmanagerURL)
+
+	if c.dryRun {
+		previewParams := silence.NewPreviewSilenceParams().WithContext(ctx).
+			WithSilence(ps)
+		previewOk, err := amclient.Silence.PreviewSilence(previewParams)
+		if err != nil {
+			return err
+		}
+		formatter, found := format.Formatters[output]
+		if !found {
+			return errors.New("unknown output formatter")
+		}
+		return formatter.FormatAlerts(previewOk.Payload.Alerts)
+	}
+
 	silenceParams := silence.NewPostSilencesParams().WithContext(ctx).
 		WithSilence(ps)
 
-	amclient := NewAlertmanagerClient(alertmanagerURL)
-
 	postOk, err := amclient.Silence.PostSilences(silenceParams)
 	if err != nil {
 		return err
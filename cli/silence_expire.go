@@ -24,7 +24,8 @@ import (
 )
 
 type silenceExpireCmd struct {
-	ids []string
+	ids    []string
+	author string
 }
 
 func configureSilenceExpireCmd(cc *kingpin.CmdClause) {
@@ -32,6 +33,7 @@ func configureSilenceExpireCmd(cc *kingpin.CmdClause) {
 		c         = &silenceExpireCmd{}
 		expireCmd = cc.Command("expire", "expire an alertmanager silence")
 	)
+	expireCmd.Flag("author", "Username recorded in the history of the silences").Short('a').Default(username()).StringVar(&c.author)
 	expireCmd.Arg("silence-ids", "Ids of silences to expire").StringsVar(&c.ids)
 	expireCmd.Action(execWithTimeout(c.expire))
 }
@@ -46,6 +48,7 @@ func (c *silenceExpireCmd) expire(ctx context.Context, _ *kingpin.ParseContext)
 	for _, id := range c.ids {
 		params := silence.NewDeleteSilenceParams().WithContext(ctx)
 		params.SilenceID = strfmt.UUID(id)
+		params.Author = &c.author
 		_, err := amclient.Silence.DeleteSilence(params)
 		if err != nil {
 			return err
@@ -42,11 +42,13 @@ import (
 	"github.com/prometheus/exporter-toolkit/web"
 	webflag "github.com/prometheus/exporter-toolkit/web/kingpinflag"
 
+	"github.com/prometheus/alertmanager/ack"
 	"github.com/prometheus/alertmanager/api"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/config/receiver"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/events"
 	"github.com/prometheus/alertmanager/featurecontrol"
 	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/matchers/compat"
@@ -141,13 +143,24 @@ func run() int {
 		configFile          = kingpin.Flag("config.file", "Alertmanager configuration file name.").Default("alertmanager.yml").String()
 		dataDir             = kingpin.Flag("storage.path", "Base path for data storage.").Default("data/").String()
 		retention           = kingpin.Flag("data.retention", "How long to keep data for.").Default("120h").Duration()
-		maintenanceInterval = kingpin.Flag("data.maintenance-interval", "Interval between garbage collection and snapshotting to disk of the silences and the notification logs.").Default("15m").Duration()
+		maintenanceInterval = kingpin.Flag("data.maintenance-interval", "Interval between garbage collection and snapshotting to disk of the silences, the acknowledgements, the notification logs and the alert log.").Default("15m").Duration()
 		alertGCInterval     = kingpin.Flag("alerts.gc-interval", "Interval between alert GC.").Default("30m").Duration()
 
+		maxAlerts                 = kingpin.Flag("alerts.max-alerts", "Maximum number of alerts in the store. New alerts are rejected once it is reached. 0 means no limit.").Default("0").Int()
+		maxAlertSizeBytes         = kingpin.Flag("alerts.max-alert-size-bytes", "Maximum size of a single alert, computed over its labels, annotations and generator URL. 0 means no limit.").Default("0").Int()
+		limitLabel                = kingpin.Flag("alerts.limit-label", "Name of the label whose values are limited separately by --alerts.max-alerts-per-label-value and --alerts.max-size-bytes-per-label-value, such as 'tenant' or 'cluster'.").Default("").String()
+		maxAlertsPerLabelValue    = kingpin.Flag("alerts.max-alerts-per-label-value", "Maximum number of alerts in the store with the same value of the label set by --alerts.limit-label. 0 means no limit.").Default("0").Int()
+		maxSizeBytesPerLabelValue = kingpin.Flag("alerts.max-size-bytes-per-label-value", "Maximum total size of the alerts in the store with the same value of the label set by --alerts.limit-label. 0 means no limit.").Default("0").Int()
+
+		maxDeadLetters             = kingpin.Flag("notifications.max-dead-letters", "Maximum number of failed notifications kept in the dead-letter store. The oldest ones are dropped once it is reached.").Default("1000").Int()
+		circuitBreakerThreshold    = kingpin.Flag("notifications.circuit-breaker.failure-threshold", "Number of consecutive failed notification attempts after which the circuit breaker of an integration opens. 0 disables the circuit breakers.").Default("0").Int()
+		circuitBreakerOpenDuration = kingpin.Flag("notifications.circuit-breaker.open-duration", "Time an open circuit breaker fails notifications fast before letting a probe notification through.").Default("1m").Duration()
+
 		webConfig      = webflag.AddFlags(kingpin.CommandLine, ":9093")
 		externalURL    = kingpin.Flag("web.external-url", "The URL under which Alertmanager is externally reachable (for example, if Alertmanager is served via a reverse proxy). Used for generating relative and absolute links back to Alertmanager itself. If the URL has a path portion, it will be used to prefix all HTTP endpoints served by Alertmanager. If omitted, relevant URL components will be derived automatically.").String()
 		routePrefix    = kingpin.Flag("web.route-prefix", "Prefix for the internal routes of web endpoints. Defaults to path of --web.external-url.").String()
 		getConcurrency = kingpin.Flag("web.get-concurrency", "Maximum number of GET requests processed concurrently. If negative or zero, the limit is GOMAXPROC or 8, whichever is larger.").Default("0").Int()
+		eventClients   = kingpin.Flag("web.event-stream-clients", "Maximum number of clients of the event stream. If negative or zero, the limit is 64.").Default("0").Int()
 		httpTimeout    = kingpin.Flag("web.timeout", "Timeout for HTTP requests. If negative or zero, no timeout is set.").Default("0").Duration()
 
 		clusterBindAddr = kingpin.Flag("cluster.listen-address", "Listen address for cluster. Set to empty string to disable HA mode.").
@@ -269,6 +282,24 @@ func run() int {
 		silences.SetBroadcast(c.Broadcast)
 	}
 
+	deadLetters, err := notify.NewDeadLetterStore(filepath.Join(*dataDir, "dead_letters"), *maxDeadLetters, log.With(logger, "component", "dead-letters"), prometheus.DefaultRegisterer)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+
+	wg.Add(1)
+	go func() {
+		deadLetters.Maintenance(*maintenanceInterval, stopc)
+		wg.Done()
+	}()
+
+	breakers, err := notify.NewCircuitBreakers(*circuitBreakerThreshold, *circuitBreakerOpenDuration, prometheus.DefaultRegisterer)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+
 	// Start providers before router potentially sends updates.
 	wg.Add(1)
 	go func() {
@@ -276,6 +307,28 @@ func run() int {
 		wg.Done()
 	}()
 
+	acks, err := ack.New(ack.Options{
+		SnapshotFile: filepath.Join(*dataDir, "acks"),
+		Retention:    *retention,
+		Marker:       marker,
+		Logger:       log.With(logger, "component", "acks"),
+		Metrics:      prometheus.DefaultRegisterer,
+	})
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	if peer != nil {
+		c := peer.AddState("ack", acks, prometheus.DefaultRegisterer)
+		acks.SetBroadcast(c.Broadcast)
+	}
+
+	wg.Add(1)
+	go func() {
+		acks.Maintenance(*maintenanceInterval, filepath.Join(*dataDir, "acks"), stopc, nil)
+		wg.Done()
+	}()
+
 	defer func() {
 		close(stopc)
 		wg.Wait()
@@ -300,13 +353,46 @@ func run() int {
 		go peer.Settle(ctx, *gossipInterval*10)
 	}
 
-	alerts, err := mem.NewAlerts(context.Background(), marker, *alertGCInterval, nil, logger, prometheus.DefaultRegisterer)
+	if *limitLabel != "" && !model.LabelName(*limitLabel).IsValid() {
+		level.Error(logger).Log("msg", "invalid label name for --alerts.limit-label", "label", *limitLabel)
+		return 1
+	}
+	alertLimits := mem.NewLimitsCallback(mem.Limits{
+		MaxAlerts:                 *maxAlerts,
+		MaxAlertSizeBytes:         *maxAlertSizeBytes,
+		Label:                     model.LabelName(*limitLabel),
+		MaxAlertsPerLabelValue:    *maxAlertsPerLabelValue,
+		MaxSizeBytesPerLabelValue: *maxSizeBytesPerLabelValue,
+	}, prometheus.DefaultRegisterer)
+
+	// The acknowledgements of the alerts are removed once they resolve.
+	alerts, err := mem.NewAlerts(context.Background(), marker, *alertGCInterval, mem.NewMultiCallback(alertLimits, acks), logger, prometheus.DefaultRegisterer)
 	if err != nil {
 		level.Error(logger).Log("err", err)
 		return 1
 	}
 	defer alerts.Close()
 
+	// Restore the alerts from the alert log before anything subscribes to
+	// them, so that the dispatcher and the inhibitor see them on startup.
+	if err := alerts.OpenLog(filepath.Join(*dataDir, "alerts")); err != nil {
+		level.Error(logger).Log("msg", "error opening alert log", "err", err)
+		return 1
+	}
+	wg.Add(1)
+	go func() {
+		alerts.Maintenance(*maintenanceInterval, stopc)
+		wg.Done()
+	}()
+
+	eventHub := events.NewHub(log.With(logger, "component", "events"), prometheus.DefaultRegisterer)
+	silences.SetChangeCallback(eventHub.PublishSilence)
+	wg.Add(1)
+	go func() {
+		eventHub.Run(alerts, stopc)
+		wg.Done()
+	}()
+
 	var disp *dispatch.Dispatcher
 	defer func() {
 		disp.Stop()
@@ -316,6 +402,10 @@ func run() int {
 		return disp.Groups(routeFilter, alertFilter)
 	}
 
+	limitedFn := func() map[model.Fingerprint][]string {
+		return disp.LimitedAlerts()
+	}
+
 	// An interface value that holds a nil concrete value is non-nil.
 	// Therefore we explicly pass an empty interface, to detect if the
 	// cluster is not enabled in notify.
@@ -325,15 +415,22 @@ func run() int {
 	}
 
 	api, err := api.New(api.Options{
-		Alerts:      alerts,
-		Silences:    silences,
-		StatusFunc:  marker.Status,
-		Peer:        clusterPeer,
-		Timeout:     *httpTimeout,
-		Concurrency: *getConcurrency,
-		Logger:      log.With(logger, "component", "api"),
-		Registry:    prometheus.DefaultRegisterer,
-		GroupFunc:   groupFn,
+		Alerts:          alerts,
+		Silences:        silences,
+		Acks:            acks,
+		NotificationLog: notificationLog,
+		DeadLetters:     deadLetters,
+		CircuitBreakers: breakers,
+		Events:          eventHub,
+		StatusFunc:      marker.Status,
+		Peer:            clusterPeer,
+		Timeout:         *httpTimeout,
+		Concurrency:     *getConcurrency,
+		EventClients:    *eventClients,
+		Logger:          log.With(logger, "component", "api"),
+		Registry:        prometheus.DefaultRegisterer,
+		GroupFunc:       groupFn,
+		LimitedFunc:     limitedFn,
 	})
 	if err != nil {
 		level.Error(logger).Log("err", fmt.Errorf("failed to create API: %w", err))
@@ -363,7 +460,8 @@ func run() int {
 		tmpl      *template.Template
 	)
 
-	dispMetrics := dispatch.NewDispatcherMetrics(false, prometheus.DefaultRegisterer)
+	dispMetrics := dispatch.NewDispatcherMetrics(true, prometheus.DefaultRegisterer)
+	inhibitMetrics := inhibit.NewMetrics(prometheus.DefaultRegisterer)
 	pipelineBuilder := notify.NewPipelineBuilder(prometheus.DefaultRegisterer, ff)
 	configLogger := log.With(logger, "component", "configuration")
 	configCoordinator := config.NewCoordinator(
@@ -383,6 +481,9 @@ func run() int {
 		activeReceivers := make(map[string]struct{})
 		routes.Walk(func(r *dispatch.Route) {
 			activeReceivers[r.RouteOpts.Receiver] = struct{}{}
+			for _, step := range r.RouteOpts.Escalation {
+				activeReceivers[step.Receiver] = struct{}{}
+			}
 		})
 
 		// Build the map of receiver to integrations.
@@ -418,8 +519,8 @@ func run() int {
 		inhibitor.Stop()
 		disp.Stop()
 
-		inhibitor = inhibit.NewInhibitor(alerts, conf.InhibitRules, marker, logger)
-		silencer := silence.NewSilencer(silences, marker, logger)
+		inhibitor = inhibit.NewInhibitor(alerts, conf.InhibitRules, marker, logger, inhibitMetrics)
+		silencer := silence.NewSilencer(silences, marker, intervener, logger)
 
 		// An interface value that holds a nil concrete value is non-nil.
 		// Therefore we explicly pass an empty interface, to detect if the
@@ -435,20 +536,24 @@ func run() int {
 			inhibitor,
 			silencer,
 			intervener,
+			marker,
 			notificationLog,
+			deadLetters,
+			breakers,
 			pipelinePeer,
 		)
+		deadLetters.SetReceivers(receivers)
 
 		configuredReceivers.Set(float64(len(activeReceivers)))
 		configuredIntegrations.Set(float64(integrationsNum))
 		configuredInhibitionRules.Set(float64(len(conf.InhibitRules)))
 
-		api.Update(conf, func(labels model.LabelSet) {
+		api.Update(conf, tmpl, func(labels model.LabelSet) {
 			inhibitor.Mutes(labels)
 			silencer.Mutes(labels)
-		})
+		}, inhibitor)
 
-		disp = dispatch.NewDispatcher(alerts, routes, pipeline, marker, timeoutFunc, nil, logger, dispMetrics)
+		disp = dispatch.NewDispatcher(alerts, routes, eventHub.Stage(pipeline), marker, timeoutFunc, dispatch.NewConfigLimits(conf.Limits), notificationLog, logger, dispMetrics)
 		routes.Walk(func(r *dispatch.Route) {
 			if r.RouteOpts.RepeatInterval > *retention {
 				level.Warn(configLogger).Log(
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -203,6 +204,9 @@ func LoadFile(filename string) (*Config, error) {
 	}
 
 	resolveFilepaths(filepath.Dir(filename), cfg)
+	if err := loadCalendars(cfg); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
 
@@ -258,7 +262,58 @@ func resolveFilepaths(baseDir string, cfg *Config) {
 		for _, cfg := range receiver.MSTeamsConfigs {
 			cfg.HTTPConfig.SetDirectory(baseDir)
 		}
+		for _, cfg := range receiver.JiraConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+		for _, cfg := range receiver.ServiceNowConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+		for _, cfg := range receiver.GoogleChatConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+		for _, cfg := range receiver.MatrixConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+		for _, cfg := range receiver.MattermostConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+		for _, cfg := range receiver.RocketChatConfigs {
+			cfg.HTTPConfig.SetDirectory(baseDir)
+		}
+	}
+	for _, c := range timeIntervalCalendars(cfg) {
+		c.SetDirectory(baseDir)
+	}
+}
+
+// timeIntervalCalendars returns the calendars of the time intervals.
+func timeIntervalCalendars(cfg *Config) []*timeinterval.Calendar {
+	var res []*timeinterval.Calendar
+	add := func(tis []timeinterval.TimeInterval) {
+		for i := range tis {
+			for j := range tis[i].Calendars {
+				res = append(res, &tis[i].Calendars[j])
+			}
+		}
+	}
+	for _, mt := range cfg.MuteTimeIntervals {
+		add(mt.TimeIntervals)
+	}
+	for _, ti := range cfg.TimeIntervals {
+		add(ti.TimeIntervals)
 	}
+	return res
+}
+
+// loadCalendars reads the calendar files of the time intervals, so that they
+// are reloaded along with the configuration.
+func loadCalendars(cfg *Config) error {
+	for _, c := range timeIntervalCalendars(cfg) {
+		if err := c.Load(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // MuteTimeInterval represents a named set of time intervals for which a route should be muted.
@@ -307,6 +362,8 @@ type Config struct {
 	// Deprecated. Remove before v1.0 release.
 	MuteTimeIntervals []MuteTimeInterval `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
 	TimeIntervals     []TimeInterval     `yaml:"time_intervals,omitempty" json:"time_intervals,omitempty"`
+	Limits            *Limits            `yaml:"limits,omitempty" json:"limits,omitempty"`
+	SilencePolicy     *SilencePolicy     `yaml:"silence_policy,omitempty" json:"silence_policy,omitempty"`
 
 	// original is the input from which the config was parsed.
 	original string
@@ -540,6 +597,42 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 				return fmt.Errorf("no msteams webhook URL provided")
 			}
 		}
+		for _, jira := range rcv.JiraConfigs {
+			if jira.HTTPConfig == nil {
+				jira.HTTPConfig = c.Global.HTTPConfig
+			}
+			if jira.APIURL == nil {
+				if c.Global.JiraAPIURL == nil {
+					return fmt.Errorf("no global Jira API URL set")
+				}
+				jira.APIURL = c.Global.JiraAPIURL
+			}
+		}
+		for _, sn := range rcv.ServiceNowConfigs {
+			if sn.HTTPConfig == nil {
+				sn.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, gc := range rcv.GoogleChatConfigs {
+			if gc.HTTPConfig == nil {
+				gc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, matrix := range rcv.MatrixConfigs {
+			if matrix.HTTPConfig == nil {
+				matrix.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, mm := range rcv.MattermostConfigs {
+			if mm.HTTPConfig == nil {
+				mm.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, rc := range rcv.RocketChatConfigs {
+			if rc.HTTPConfig == nil {
+				rc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
 
 		names[rcv.Name] = struct{}{}
 	}
@@ -568,6 +661,28 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 
+	// Unnamed inhibit rules are identified by their index, which mustn't
+	// collide with the name of another rule.
+	irNames := make(map[string]struct{})
+	irIndexes := make(map[string]struct{})
+	for i, ir := range c.InhibitRules {
+		if ir.Name == "" {
+			irIndexes[strconv.Itoa(i)] = struct{}{}
+		}
+	}
+	for _, ir := range c.InhibitRules {
+		if ir.Name == "" {
+			continue
+		}
+		if _, ok := irNames[ir.Name]; ok {
+			return fmt.Errorf("inhibit rule %q is not unique", ir.Name)
+		}
+		if _, ok := irIndexes[ir.Name]; ok {
+			return fmt.Errorf("inhibit rule %q collides with the index of an unnamed inhibit rule", ir.Name)
+		}
+		irNames[ir.Name] = struct{}{}
+	}
+
 	tiNames := make(map[string]struct{})
 
 	// read mute time intervals until deprecated
@@ -596,6 +711,13 @@ func checkReceiver(r *Route, receivers map[string]struct{}) error {
 			return err
 		}
 	}
+	if r.Escalation != nil {
+		for _, step := range r.Escalation.Steps {
+			if _, ok := receivers[step.Receiver]; !ok {
+				return fmt.Errorf("undefined receiver %q used in escalation", step.Receiver)
+			}
+		}
+	}
 	if r.Receiver == "" {
 		return nil
 	}
@@ -765,6 +887,7 @@ type GlobalConfig struct {
 	VictorOpsAPIKeyFile  string     `yaml:"victorops_api_key_file,omitempty" json:"victorops_api_key_file,omitempty"`
 	TelegramAPIUrl       *URL       `yaml:"telegram_api_url,omitempty" json:"telegram_api_url,omitempty"`
 	WebexAPIURL          *URL       `yaml:"webex_api_url,omitempty" json:"webex_api_url,omitempty"`
+	JiraAPIURL           *URL       `yaml:"jira_api_url,omitempty" json:"jira_api_url,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for GlobalConfig.
@@ -794,6 +917,9 @@ type Route struct {
 	GroupWait      *model.Duration `yaml:"group_wait,omitempty" json:"group_wait,omitempty"`
 	GroupInterval  *model.Duration `yaml:"group_interval,omitempty" json:"group_interval,omitempty"`
 	RepeatInterval *model.Duration `yaml:"repeat_interval,omitempty" json:"repeat_interval,omitempty"`
+
+	Limits     *Limits     `yaml:"limits,omitempty" json:"limits,omitempty"`
+	Escalation *Escalation `yaml:"escalation,omitempty" json:"escalation,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Route.
@@ -844,10 +970,108 @@ func (r *Route) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// Escalation defines additional receivers that are notified about the alerts
+// of an aggregation group when they are still firing after some delay.
+type Escalation struct {
+	Steps []EscalationStep `yaml:"steps" json:"steps"`
+}
+
+// EscalationStep notifies a receiver once the alerts of an aggregation group
+// have been firing for the given delay.
+type EscalationStep struct {
+	Delay    model.Duration `yaml:"delay" json:"delay"`
+	Receiver string         `yaml:"receiver" json:"receiver"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Escalation.
+func (e *Escalation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Escalation
+	if err := unmarshal((*plain)(e)); err != nil {
+		return err
+	}
+
+	if len(e.Steps) == 0 {
+		return fmt.Errorf("escalation must have at least one step")
+	}
+	for i, step := range e.Steps {
+		if step.Receiver == "" {
+			return fmt.Errorf("missing receiver in escalation step %d", i)
+		}
+		if i > 0 && step.Delay <= e.Steps[i-1].Delay {
+			return fmt.Errorf("escalation steps must be ordered by increasing delay")
+		}
+	}
+	return nil
+}
+
+// Limits configures the limits enforced by the dispatcher. A value of 0 means
+// no limit.
+type Limits struct {
+	// MaxAggregationGroups is the maximum number of aggregation groups. At the
+	// top level it applies to all routes together, on a route it applies to
+	// the groups of that route.
+	MaxAggregationGroups int `yaml:"max_aggregation_groups,omitempty" json:"max_aggregation_groups,omitempty"`
+	// MaxAlertsPerGroup is the maximum number of alerts in a single
+	// aggregation group.
+	MaxAlertsPerGroup int `yaml:"max_alerts_per_group,omitempty" json:"max_alerts_per_group,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Limits.
+func (l *Limits) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Limits
+	if err := unmarshal((*plain)(l)); err != nil {
+		return err
+	}
+
+	if l.MaxAggregationGroups < 0 {
+		return fmt.Errorf("max_aggregation_groups cannot be negative")
+	}
+	if l.MaxAlertsPerGroup < 0 {
+		return fmt.Errorf("max_alerts_per_group cannot be negative")
+	}
+	return nil
+}
+
+// SilencePolicy configures the restrictions enforced on the silences created
+// or updated through the API. A zero value means no restriction.
+type SilencePolicy struct {
+	// MaxDuration is the maximum time a silence can be active for, starting
+	// from the later of its start time and the time it is set.
+	MaxDuration model.Duration `yaml:"max_duration,omitempty" json:"max_duration,omitempty"`
+	// RequiredLabels are the label names each silence must have a matcher
+	// for.
+	RequiredLabels model.LabelNames `yaml:"required_labels,omitempty" json:"required_labels,omitempty"`
+	// ForbidMatchAll rejects silences with a matcher matching any non-empty
+	// value, such as =~".*", =~".+" or !~"".
+	ForbidMatchAll bool `yaml:"forbid_match_all,omitempty" json:"forbid_match_all,omitempty"`
+	// MaxSilencesPerCreator is the maximum number of active and pending
+	// silences created by the same author.
+	MaxSilencesPerCreator int `yaml:"max_silences_per_creator,omitempty" json:"max_silences_per_creator,omitempty"`
+	// CommentPattern is a regular expression the comment of each silence
+	// must match.
+	CommentPattern *Regexp `yaml:"comment_pattern,omitempty" json:"comment_pattern,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for SilencePolicy.
+func (p *SilencePolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain SilencePolicy
+	if err := unmarshal((*plain)(p)); err != nil {
+		return err
+	}
+
+	if p.MaxSilencesPerCreator < 0 {
+		return fmt.Errorf("max_silences_per_creator cannot be negative")
+	}
+	return nil
+}
+
 // InhibitRule defines an inhibition rule that mutes alerts that match the
 // target labels if an alert matching the source labels exists.
 // Both alerts have to have a set of labels being equal.
 type InhibitRule struct {
+	// Name optionally identifies the rule in metrics and the API. It must be
+	// unique if set.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
 	// SourceMatch defines a set of labels that have to equal the given
 	// value for source alerts. Deprecated. Remove before v1.0 release.
 	SourceMatch map[string]string `yaml:"source_match,omitempty" json:"source_match,omitempty"`
@@ -867,6 +1091,12 @@ type InhibitRule struct {
 	// A set of labels that must be equal between the source and target alert
 	// for them to be a match.
 	Equal model.LabelNames `yaml:"equal,omitempty" json:"equal,omitempty"`
+	// SourceMinDuration defines for how long a source alert has to be firing
+	// before it inhibits target alerts.
+	SourceMinDuration model.Duration `yaml:"source_min_duration,omitempty" json:"source_min_duration,omitempty"`
+	// TargetDelay defines for how long target alerts stay inhibited after the
+	// source alert resolved.
+	TargetDelay model.Duration `yaml:"target_delay,omitempty" json:"target_delay,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for InhibitRule.
@@ -896,19 +1126,25 @@ type Receiver struct {
 	// A unique identifier for this receiver.
 	Name string `yaml:"name" json:"name"`
 
-	DiscordConfigs   []*DiscordConfig   `yaml:"discord_configs,omitempty" json:"discord_configs,omitempty"`
-	EmailConfigs     []*EmailConfig     `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
-	PagerdutyConfigs []*PagerdutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
-	SlackConfigs     []*SlackConfig     `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
-	WebhookConfigs   []*WebhookConfig   `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
-	OpsGenieConfigs  []*OpsGenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
-	WechatConfigs    []*WechatConfig    `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
-	PushoverConfigs  []*PushoverConfig  `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
-	VictorOpsConfigs []*VictorOpsConfig `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
-	SNSConfigs       []*SNSConfig       `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
-	TelegramConfigs  []*TelegramConfig  `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
-	WebexConfigs     []*WebexConfig     `yaml:"webex_configs,omitempty" json:"webex_configs,omitempty"`
-	MSTeamsConfigs   []*MSTeamsConfig   `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
+	DiscordConfigs    []*DiscordConfig    `yaml:"discord_configs,omitempty" json:"discord_configs,omitempty"`
+	EmailConfigs      []*EmailConfig      `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+	PagerdutyConfigs  []*PagerdutyConfig  `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	SlackConfigs      []*SlackConfig      `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	WebhookConfigs    []*WebhookConfig    `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
+	OpsGenieConfigs   []*OpsGenieConfig   `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+	WechatConfigs     []*WechatConfig     `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
+	PushoverConfigs   []*PushoverConfig   `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
+	VictorOpsConfigs  []*VictorOpsConfig  `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	SNSConfigs        []*SNSConfig        `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
+	TelegramConfigs   []*TelegramConfig   `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
+	WebexConfigs      []*WebexConfig      `yaml:"webex_configs,omitempty" json:"webex_configs,omitempty"`
+	MSTeamsConfigs    []*MSTeamsConfig    `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
+	JiraConfigs       []*JiraConfig       `yaml:"jira_configs,omitempty" json:"jira_configs,omitempty"`
+	ServiceNowConfigs []*ServiceNowConfig `yaml:"servicenow_configs,omitempty" json:"servicenow_configs,omitempty"`
+	GoogleChatConfigs []*GoogleChatConfig `yaml:"googlechat_configs,omitempty" json:"googlechat_configs,omitempty"`
+	MatrixConfigs     []*MatrixConfig     `yaml:"matrix_configs,omitempty" json:"matrix_configs,omitempty"`
+	MattermostConfigs []*MattermostConfig `yaml:"mattermost_configs,omitempty" json:"mattermost_configs,omitempty"`
+	RocketChatConfigs []*RocketChatConfig `yaml:"rocketchat_configs,omitempty" json:"rocketchat_configs,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Receiver.
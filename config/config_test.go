@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
@@ -508,6 +509,205 @@ receivers:
 	}
 }
 
+func TestLimitsAreNotNegative(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+    limits:
+      max_alerts_per_group: -1
+
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "max_alerts_per_group cannot be negative"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestSilencePolicy(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+silence_policy:
+  max_duration: 7d
+  required_labels: [team]
+  comment_pattern: '[A-Z]+-[0-9]+'
+`
+	c, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, model.Duration(7*24*time.Hour), c.SilencePolicy.MaxDuration)
+	require.Equal(t, model.LabelNames{"team"}, c.SilencePolicy.RequiredLabels)
+	require.True(t, c.SilencePolicy.CommentPattern.MatchString("OPS-1"))
+
+	_, err = Load(`
+route:
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+silence_policy:
+  max_silences_per_creator: -1
+`)
+	require.EqualError(t, err, "max_silences_per_creator cannot be negative")
+
+	_, err = Load(`
+route:
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+silence_policy:
+  required_labels: ["0team"]
+`)
+	require.EqualError(t, err, `"0team" is not a valid label name`)
+}
+
+func TestInhibitRuleNameIsUnique(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+inhibit_rules:
+- name: datacenter-down
+  source_matchers: [alertname="DatacenterDown"]
+  target_matchers: [severity="warning"]
+- source_matchers: [alertname="ClusterDown"]
+  target_matchers: [severity="warning"]
+- name: datacenter-down
+  source_matchers: [alertname="DatacenterDown"]
+  target_matchers: [severity="critical"]
+`
+	_, err := Load(in)
+	require.EqualError(t, err, `inhibit rule "datacenter-down" is not unique`)
+}
+
+func TestInhibitRuleNameCollidesWithIndex(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+inhibit_rules:
+- name: "1"
+  source_matchers: [alertname="DatacenterDown"]
+  target_matchers: [severity="warning"]
+- source_matchers: [alertname="ClusterDown"]
+  target_matchers: [severity="warning"]
+`
+	_, err := Load(in)
+	require.EqualError(t, err, `inhibit rule "1" collides with the index of an unnamed inhibit rule`)
+}
+
+func TestTimeIntervalCalendars(t *testing.T) {
+	c, err := LoadFile("testdata/conf.time-interval-calendars.yml")
+	require.NoError(t, err)
+
+	cal := c.TimeIntervals[0].TimeIntervals[0].Calendars[0]
+	require.Equal(t, filepath.Join("testdata", "holidays.ics"), cal.Path)
+
+	christmas := time.Date(2030, 12, 25, 12, 0, 0, 0, time.UTC)
+	require.True(t, c.TimeIntervals[0].TimeIntervals[0].ContainsTime(christmas))
+	require.False(t, c.TimeIntervals[0].TimeIntervals[0].ContainsTime(christmas.AddDate(0, 0, 1)))
+
+	tmp := t.TempDir()
+	b, err := os.ReadFile("testdata/conf.time-interval-calendars.yml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, "alertmanager.yml"), b, 0o644))
+	_, err = LoadFile(filepath.Join(tmp, "alertmanager.yml"))
+	require.ErrorContains(t, err, "failed to read calendar")
+}
+
+func TestEscalation(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		err  string
+	}{
+		{
+			name: "valid",
+			in: `
+route:
+    receiver: team-chat
+    escalation:
+      steps:
+      - delay: 15m
+        receiver: primary
+      - delay: 45m
+        receiver: secondary
+
+receivers:
+- name: team-chat
+- name: primary
+- name: secondary
+`,
+		},
+		{
+			name: "undefined receiver",
+			in: `
+route:
+    receiver: team-chat
+    escalation:
+      steps:
+      - delay: 15m
+        receiver: primary
+
+receivers:
+- name: team-chat
+`,
+			err: `undefined receiver "primary" used in escalation`,
+		},
+		{
+			name: "unordered steps",
+			in: `
+route:
+    receiver: team-chat
+    escalation:
+      steps:
+      - delay: 45m
+        receiver: secondary
+      - delay: 15m
+        receiver: primary
+
+receivers:
+- name: team-chat
+- name: primary
+- name: secondary
+`,
+			err: "escalation steps must be ordered by increasing delay",
+		},
+		{
+			name: "no steps",
+			in: `
+route:
+    receiver: team-chat
+    escalation:
+      steps: []
+
+receivers:
+- name: team-chat
+`,
+			err: "escalation must have at least one step",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load(tc.in)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
 func TestHideConfigSecrets(t *testing.T) {
 	c, err := LoadFile("testdata/conf.good.yml")
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
 	"github.com/prometheus/common/sigv4"
 )
 
@@ -172,17 +173,161 @@ var (
 		Summary: `{{ template "msteams.default.summary" . }}`,
 		Text:    `{{ template "msteams.default.text" . }}`,
 	}
+
+	// DefaultJiraConfig defines default values for Jira configurations.
+	DefaultJiraConfig = JiraConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Summary:     `{{ template "__subject" . }}`,
+		Description: `{{ template "__text_alert_list" .Alerts }}`,
+		Comment:     `{{ template "__text_alert_list" .Alerts }}`,
+	}
+
+	// DefaultServiceNowConfig defines default values for ServiceNow configurations.
+	DefaultServiceNowConfig = ServiceNowConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Table:            "incident",
+		ShortDescription: `{{ template "__subject" . }}`,
+		Description:      `{{ template "__text_alert_list" .Alerts }}`,
+		CloseNotes:       `{{ template "__subject" . }}`,
+		ResolveState:     "6",
+	}
+
+	// DefaultGoogleChatConfig defines default values for Google Chat configurations.
+	DefaultGoogleChatConfig = GoogleChatConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Title: `{{ template "__subject" . }}`,
+		Sections: []*GoogleChatSection{
+			{Text: `{{ template "__text_alert_list" .Alerts }}`},
+		},
+	}
+
+	// DefaultMatrixConfig defines default values for Matrix configurations.
+	DefaultMatrixConfig = MatrixConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		MessageType: "m.text",
+		Message: `{{ template "__subject" . }}
+{{ template "__text_alert_list" .Alerts }}`,
+	}
+
+	// DefaultMattermostConfig defines default values for Mattermost configurations.
+	DefaultMattermostConfig = MattermostConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: false,
+		},
+		Color:     `{{ if eq .Status "firing" }}#A30200{{ else }}#2EB886{{ end }}`,
+		Title:     `{{ template "__subject" . }}`,
+		TitleLink: `{{ template "__alertmanagerURL" . }}`,
+		Text:      `{{ template "__text_alert_list" .Alerts }}`,
+		Fallback:  `{{ template "__subject" . }}`,
+	}
+
+	// DefaultRocketChatConfig defines default values for Rocket.Chat configurations.
+	DefaultRocketChatConfig = RocketChatConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: false,
+		},
+		Color:     `{{ if eq .Status "firing" }}#A30200{{ else }}#2EB886{{ end }}`,
+		Title:     `{{ template "__subject" . }}`,
+		TitleLink: `{{ template "__alertmanagerURL" . }}`,
+		Text:      `{{ template "__text_alert_list" .Alerts }}`,
+	}
 )
 
 // NotifierConfig contains base options common across all notifier configurations.
 type NotifierConfig struct {
-	VSendResolved bool `yaml:"send_resolved" json:"send_resolved"`
+	VSendResolved bool         `yaml:"send_resolved" json:"send_resolved"`
+	VRetryConfig  *RetryConfig `yaml:"retry_config,omitempty" json:"retry_config,omitempty"`
 }
 
 func (nc *NotifierConfig) SendResolved() bool {
 	return nc.VSendResolved
 }
 
+// RetryConfig returns the retry configuration of the notifier, nil if the
+// defaults apply.
+func (nc *NotifierConfig) RetryConfig() *RetryConfig {
+	return nc.VRetryConfig
+}
+
+// DefaultRetryConfig defines the default values for retry configurations.
+var DefaultRetryConfig = RetryConfig{
+	InitialInterval: model.Duration(500 * time.Millisecond),
+	Multiplier:      1.5,
+	MaxInterval:     model.Duration(time.Minute),
+	Jitter:          0.5,
+}
+
+// RetryConfig configures how failed notifications of an integration are
+// retried.
+type RetryConfig struct {
+	// InitialInterval is the time to wait before the first retry.
+	InitialInterval model.Duration `yaml:"initial_interval,omitempty" json:"initial_interval,omitempty"`
+	// Multiplier is the factor the interval grows by after each retry.
+	Multiplier float64 `yaml:"multiplier,omitempty" json:"multiplier,omitempty"`
+	// MaxInterval caps the time to wait between two retries.
+	MaxInterval model.Duration `yaml:"max_interval,omitempty" json:"max_interval,omitempty"`
+	// MaxAttempts is the maximum number of attempts, 0 means that attempts
+	// are only bounded by the notification timeout.
+	MaxAttempts int `yaml:"max_attempts,omitempty" json:"max_attempts,omitempty"`
+	// Jitter randomizes each interval by up to this fraction of it.
+	Jitter float64 `yaml:"jitter" json:"jitter"`
+	// RetryStatusCodes are HTTP status codes which are retried.
+	RetryStatusCodes []int `yaml:"retry_status_codes,omitempty" json:"retry_status_codes,omitempty"`
+	// NoRetryStatusCodes are HTTP status codes which are not retried.
+	NoRetryStatusCodes []int `yaml:"no_retry_status_codes,omitempty" json:"no_retry_status_codes,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *RetryConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultRetryConfig
+	type plain RetryConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.InitialInterval <= 0 {
+		return fmt.Errorf("initial_interval must be positive")
+	}
+	if c.Multiplier < 1 {
+		return fmt.Errorf("multiplier must be at least 1")
+	}
+	if c.MaxInterval < c.InitialInterval {
+		return fmt.Errorf("max_interval must not be lower than initial_interval")
+	}
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("max_attempts must not be negative")
+	}
+	if c.Jitter < 0 || c.Jitter > 1 {
+		return fmt.Errorf("jitter must be between 0 and 1")
+	}
+
+	retried := map[int]struct{}{}
+	for _, code := range c.RetryStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid status code %d in retry_status_codes", code)
+		}
+		retried[code] = struct{}{}
+	}
+	for _, code := range c.NoRetryStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid status code %d in no_retry_status_codes", code)
+		}
+		if _, ok := retried[code]; ok {
+			return fmt.Errorf("status code %d is in both retry_status_codes and no_retry_status_codes", code)
+		}
+	}
+
+	return nil
+}
+
 // WebexConfig configures notifications via Webex.
 type WebexConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
@@ -798,3 +943,323 @@ func (c *MSTeamsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	type plain MSTeamsConfig
 	return unmarshal((*plain)(c))
 }
+
+// JiraConfig configures notifications via Jira.
+type JiraConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	APIURL         *URL                        `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+
+	Project     string   `yaml:"project,omitempty" json:"project,omitempty"`
+	IssueType   string   `yaml:"issue_type,omitempty" json:"issue_type,omitempty"`
+	Summary     string   `yaml:"summary,omitempty" json:"summary,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Comment     string   `yaml:"comment,omitempty" json:"comment,omitempty"`
+	Priority    string   `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Labels      []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	// Fields are additional fields of the issues, such as custom fields.
+	Fields map[string]interface{} `yaml:"fields,omitempty" json:"fields,omitempty"`
+
+	// ResolveTransition is the name of the transition, or of the status it
+	// leads to, which is applied to the issue when the group is resolved.
+	ResolveTransition string `yaml:"resolve_transition,omitempty" json:"resolve_transition,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *JiraConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultJiraConfig
+	type plain JiraConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.Project == "" {
+		return fmt.Errorf("missing project on jira_config")
+	}
+	if c.IssueType == "" {
+		return fmt.Errorf("missing issue_type on jira_config")
+	}
+
+	for k, v := range c.Fields {
+		f, err := stringKeys(v)
+		if err != nil {
+			return fmt.Errorf("invalid field %q on jira_config: %w", k, err)
+		}
+		c.Fields[k] = f
+	}
+	return nil
+}
+
+// stringKeys converts the maps decoded from YAML, which have keys of any type,
+// to maps with string keys so that they can be encoded to JSON.
+func stringKeys(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			ks, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("non-string key %v", k)
+			}
+			f, err := stringKeys(e)
+			if err != nil {
+				return nil, err
+			}
+			m[ks] = f
+		}
+		return m, nil
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			f, err := stringKeys(e)
+			if err != nil {
+				return nil, err
+			}
+			l[i] = f
+		}
+		return l, nil
+	default:
+		return v, nil
+	}
+}
+
+// ServiceNowConfig configures notifications via ServiceNow.
+type ServiceNowConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	APIURL         *URL                        `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+
+	// Table is the table of the Table API in which the records are kept.
+	Table string `yaml:"table,omitempty" json:"table,omitempty"`
+
+	ShortDescription string            `yaml:"short_description,omitempty" json:"short_description,omitempty"`
+	Description      string            `yaml:"description,omitempty" json:"description,omitempty"`
+	AssignmentGroup  string            `yaml:"assignment_group,omitempty" json:"assignment_group,omitempty"`
+	Impact           string            `yaml:"impact,omitempty" json:"impact,omitempty"`
+	Urgency          string            `yaml:"urgency,omitempty" json:"urgency,omitempty"`
+	Fields           map[string]string `yaml:"fields,omitempty" json:"fields,omitempty"`
+
+	// ResolveState is the state in which incidents are put when the group is
+	// resolved, along with the close code and notes.
+	ResolveState string `yaml:"resolve_state,omitempty" json:"resolve_state,omitempty"`
+	CloseCode    string `yaml:"close_code,omitempty" json:"close_code,omitempty"`
+	CloseNotes   string `yaml:"close_notes,omitempty" json:"close_notes,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *ServiceNowConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultServiceNowConfig
+	type plain ServiceNowConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.APIURL == nil {
+		return fmt.Errorf("missing api_url on servicenow_config")
+	}
+	if c.Table == "" {
+		return fmt.Errorf("missing table on servicenow_config")
+	}
+	if c.ResolveState == "" {
+		return fmt.Errorf("missing resolve_state on servicenow_config")
+	}
+	return nil
+}
+
+// GoogleChatConfig configures notifications via Google Chat.
+type GoogleChatConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	WebhookURL     *SecretURL `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	WebhookURLFile string     `yaml:"webhook_url_file,omitempty" json:"webhook_url_file,omitempty"`
+
+	// Threading posts the notifications of an aggregation group in the same
+	// thread.
+	Threading bool `yaml:"threading,omitempty" json:"threading,omitempty"`
+
+	Text     string               `yaml:"text,omitempty" json:"text,omitempty"`
+	Title    string               `yaml:"title,omitempty" json:"title,omitempty"`
+	Subtitle string               `yaml:"subtitle,omitempty" json:"subtitle,omitempty"`
+	Sections []*GoogleChatSection `yaml:"sections,omitempty" json:"sections,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *GoogleChatConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultGoogleChatConfig
+	type plain GoogleChatConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.WebhookURL == nil && c.WebhookURLFile == "" {
+		return fmt.Errorf("one of webhook_url or webhook_url_file must be configured")
+	}
+	if c.WebhookURL != nil && len(c.WebhookURLFile) > 0 {
+		return fmt.Errorf("at most one of webhook_url & webhook_url_file must be configured")
+	}
+	return nil
+}
+
+// GoogleChatSection is a section of the card of a Google Chat message.
+type GoogleChatSection struct {
+	Header      string `yaml:"header,omitempty" json:"header,omitempty"`
+	Text        string `yaml:"text,omitempty" json:"text,omitempty"`
+	Collapsible bool   `yaml:"collapsible,omitempty" json:"collapsible,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for GoogleChatSection.
+func (c *GoogleChatSection) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain GoogleChatSection
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.Text == "" {
+		return fmt.Errorf("missing text in Google Chat section")
+	}
+	return nil
+}
+
+// MatrixConfig configures notifications via Matrix.
+type MatrixConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	HomeserverURL   *URL   `yaml:"homeserver_url,omitempty" json:"homeserver_url,omitempty"`
+	AccessToken     Secret `yaml:"access_token,omitempty" json:"access_token,omitempty"`
+	AccessTokenFile string `yaml:"access_token_file,omitempty" json:"access_token_file,omitempty"`
+	RoomID          string `yaml:"room_id,omitempty" json:"room_id,omitempty"`
+
+	MessageType string `yaml:"message_type,omitempty" json:"message_type,omitempty"`
+	Message     string `yaml:"message,omitempty" json:"message,omitempty"`
+	// HTMLMessage is the HTML-formatted body of the messages, sent along
+	// with the plain-text body if it isn't empty.
+	HTMLMessage string `yaml:"html_message,omitempty" json:"html_message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *MatrixConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultMatrixConfig
+	type plain MatrixConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.HomeserverURL == nil {
+		return fmt.Errorf("missing homeserver_url on matrix_config")
+	}
+	if c.RoomID == "" {
+		return fmt.Errorf("missing room_id on matrix_config")
+	}
+	if c.AccessToken == "" && c.AccessTokenFile == "" {
+		return fmt.Errorf("missing access_token or access_token_file on matrix_config")
+	}
+	if c.AccessToken != "" && c.AccessTokenFile != "" {
+		return fmt.Errorf("at most one of access_token & access_token_file must be configured")
+	}
+	if c.HTTPConfig != nil && (c.HTTPConfig.Authorization != nil || c.HTTPConfig.BasicAuth != nil || c.HTTPConfig.OAuth2 != nil) {
+		return fmt.Errorf("http_config authentication can't be used along with the access token on matrix_config")
+	}
+	if c.MessageType != "m.text" && c.MessageType != "m.notice" {
+		return fmt.Errorf("unknown message_type on matrix_config, must be m.text or m.notice")
+	}
+	return nil
+}
+
+// MattermostConfig configures notifications via Mattermost incoming webhooks.
+type MattermostConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	WebhookURL     *SecretURL `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	WebhookURLFile string     `yaml:"webhook_url_file,omitempty" json:"webhook_url_file,omitempty"`
+
+	// Overrides of the channel (like town-square or @username), user name
+	// and icon of the webhook.
+	Channel   string `yaml:"channel,omitempty" json:"channel,omitempty"`
+	Username  string `yaml:"username,omitempty" json:"username,omitempty"`
+	IconURL   string `yaml:"icon_url,omitempty" json:"icon_url,omitempty"`
+	IconEmoji string `yaml:"icon_emoji,omitempty" json:"icon_emoji,omitempty"`
+
+	Color       string        `yaml:"color,omitempty" json:"color,omitempty"`
+	Title       string        `yaml:"title,omitempty" json:"title,omitempty"`
+	TitleLink   string        `yaml:"title_link,omitempty" json:"title_link,omitempty"`
+	Pretext     string        `yaml:"pretext,omitempty" json:"pretext,omitempty"`
+	Text        string        `yaml:"text,omitempty" json:"text,omitempty"`
+	Fallback    string        `yaml:"fallback,omitempty" json:"fallback,omitempty"`
+	Footer      string        `yaml:"footer,omitempty" json:"footer,omitempty"`
+	Fields      []*SlackField `yaml:"fields,omitempty" json:"fields,omitempty"`
+	ShortFields bool          `yaml:"short_fields" json:"short_fields,omitempty"`
+
+	// Card is the Markdown content displayed in the sidebar when the card
+	// of the message is opened.
+	Card string `yaml:"card,omitempty" json:"card,omitempty"`
+	// Priority of the message, either empty, important or urgent.
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *MattermostConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultMattermostConfig
+	type plain MattermostConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.WebhookURL == nil && c.WebhookURLFile == "" {
+		return fmt.Errorf("one of webhook_url or webhook_url_file must be configured")
+	}
+	if c.WebhookURL != nil && len(c.WebhookURLFile) > 0 {
+		return fmt.Errorf("at most one of webhook_url & webhook_url_file must be configured")
+	}
+	switch c.Priority {
+	case "", "important", "urgent":
+	default:
+		return fmt.Errorf("invalid priority %q, must be one of important or urgent", c.Priority)
+	}
+	return nil
+}
+
+// RocketChatConfig configures notifications via Rocket.Chat incoming webhooks.
+type RocketChatConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+	HTTPConfig     *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	WebhookURL     *SecretURL `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+	WebhookURLFile string     `yaml:"webhook_url_file,omitempty" json:"webhook_url_file,omitempty"`
+
+	// Overrides of the channel (like #general or @username), displayed name
+	// and avatar of the webhook. The emoji takes precedence over the avatar.
+	Channel string `yaml:"channel,omitempty" json:"channel,omitempty"`
+	Alias   string `yaml:"alias,omitempty" json:"alias,omitempty"`
+	Avatar  string `yaml:"avatar,omitempty" json:"avatar,omitempty"`
+	Emoji   string `yaml:"emoji,omitempty" json:"emoji,omitempty"`
+
+	// Message is the text of the message, displayed above the attachment.
+	Message     string        `yaml:"message,omitempty" json:"message,omitempty"`
+	Color       string        `yaml:"color,omitempty" json:"color,omitempty"`
+	Title       string        `yaml:"title,omitempty" json:"title,omitempty"`
+	TitleLink   string        `yaml:"title_link,omitempty" json:"title_link,omitempty"`
+	Text        string        `yaml:"text,omitempty" json:"text,omitempty"`
+	Collapsed   bool          `yaml:"collapsed,omitempty" json:"collapsed,omitempty"`
+	Fields      []*SlackField `yaml:"fields,omitempty" json:"fields,omitempty"`
+	ShortFields bool          `yaml:"short_fields" json:"short_fields,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *RocketChatConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultRocketChatConfig
+	type plain RocketChatConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.WebhookURL == nil && c.WebhookURLFile == "" {
+		return fmt.Errorf("one of webhook_url or webhook_url_file must be configured")
+	}
+	if c.WebhookURL != nil && len(c.WebhookURLFile) > 0 {
+		return fmt.Errorf("at most one of webhook_url & webhook_url_file must be configured")
+	}
+	return nil
+}
@@ -976,6 +976,332 @@ http_config:
 	}
 }
 
+func TestJiraConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no project - it fails",
+			in: `
+issue_type: Bug
+`,
+			expected: errors.New("missing project on jira_config"),
+		},
+		{
+			name: "with no issue_type - it fails",
+			in: `
+project: OPS
+`,
+			expected: errors.New("missing issue_type on jira_config"),
+		},
+		{
+			name: "with non-string field keys - it fails",
+			in: `
+project: OPS
+issue_type: Bug
+fields:
+  customfield_10000:
+    1: x
+`,
+			expected: errors.New(`invalid field "customfield_10000" on jira_config: non-string key 1`),
+		},
+		{
+			name: "with project, issue_type and fields - it succeeds",
+			in: `
+project: OPS
+issue_type: Bug
+fields:
+  customfield_10000:
+    value: '{{ .CommonLabels.team }}'
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg JiraConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.expected.Error())
+		})
+	}
+}
+
+func TestServiceNowConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no api_url - it fails",
+			in: `
+assignment_group: ops
+`,
+			expected: errors.New("missing api_url on servicenow_config"),
+		},
+		{
+			name: "with an empty table - it fails",
+			in: `
+api_url: https://example.service-now.com
+table: ''
+`,
+			expected: errors.New("missing table on servicenow_config"),
+		},
+		{
+			name: "with api_url set - it succeeds",
+			in: `
+api_url: https://example.service-now.com
+assignment_group: ops
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg ServiceNowConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestGoogleChatConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no webhook_url nor webhook_url_file - it fails",
+			in: `
+title: xyz
+`,
+			expected: errors.New("one of webhook_url or webhook_url_file must be configured"),
+		},
+		{
+			name: "with both webhook_url and webhook_url_file - it fails",
+			in: `
+webhook_url: https://chat.googleapis.com/v1/spaces/AAA/messages
+webhook_url_file: /file
+`,
+			expected: errors.New("at most one of webhook_url & webhook_url_file must be configured"),
+		},
+		{
+			name: "with a section without text - it fails",
+			in: `
+webhook_url_file: /file
+sections:
+- header: xyz
+`,
+			expected: errors.New("missing text in Google Chat section"),
+		},
+		{
+			name: "with webhook_url_file and sections - it succeeds",
+			in: `
+webhook_url_file: /file
+threading: true
+sections:
+- header: xyz
+  text: abc
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg GoogleChatConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestMatrixConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no room_id - it fails",
+			in: `
+homeserver_url: https://matrix.example.org
+access_token: xyz
+`,
+			expected: errors.New("missing room_id on matrix_config"),
+		},
+		{
+			name: "with no access_token nor access_token_file - it fails",
+			in: `
+homeserver_url: https://matrix.example.org
+room_id: '!room:example.org'
+`,
+			expected: errors.New("missing access_token or access_token_file on matrix_config"),
+		},
+		{
+			name: "with both access_token and access_token_file - it fails",
+			in: `
+homeserver_url: https://matrix.example.org
+room_id: '!room:example.org'
+access_token: xyz
+access_token_file: /file
+`,
+			expected: errors.New("at most one of access_token & access_token_file must be configured"),
+		},
+		{
+			name: "with http_config.authorization - it fails",
+			in: `
+homeserver_url: https://matrix.example.org
+room_id: '!room:example.org'
+access_token: xyz
+http_config:
+  authorization:
+    credentials: abc
+`,
+			expected: errors.New("http_config authentication can't be used along with the access token on matrix_config"),
+		},
+		{
+			name: "with an unknown message_type - it fails",
+			in: `
+homeserver_url: https://matrix.example.org
+room_id: '!room:example.org'
+access_token_file: /file
+message_type: m.emote
+`,
+			expected: errors.New("unknown message_type on matrix_config, must be m.text or m.notice"),
+		},
+		{
+			name: "with homeserver_url, room_id and access_token_file - it succeeds",
+			in: `
+homeserver_url: https://matrix.example.org
+room_id: '!room:example.org'
+access_token_file: /file
+html_message: '<b>{{ .Status }}</b>'
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg MatrixConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestMattermostConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no webhook_url nor webhook_url_file - it fails",
+			in: `
+channel: town-square
+`,
+			expected: errors.New("one of webhook_url or webhook_url_file must be configured"),
+		},
+		{
+			name: "with both webhook_url and webhook_url_file - it fails",
+			in: `
+webhook_url: https://mattermost.example.org/hooks/xxx
+webhook_url_file: /file
+`,
+			expected: errors.New("at most one of webhook_url & webhook_url_file must be configured"),
+		},
+		{
+			name: "with a field without value - it fails",
+			in: `
+webhook_url_file: /file
+fields:
+- title: Team
+`,
+			expected: errors.New("missing value in Slack field configuration"),
+		},
+		{
+			name: "with an invalid priority - it fails",
+			in: `
+webhook_url_file: /file
+priority: critical
+`,
+			expected: errors.New(`invalid priority "critical", must be one of important or urgent`),
+		},
+		{
+			name: "with webhook_url, card, priority and fields - it succeeds",
+			in: `
+webhook_url: https://mattermost.example.org/hooks/xxx
+card: '{{ .CommonAnnotations.description }}'
+priority: urgent
+fields:
+- title: Team
+  value: '{{ .CommonLabels.team }}'
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg MattermostConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestRocketChatConfiguration(t *testing.T) {
+	tc := []struct {
+		name string
+
+		in       string
+		expected error
+	}{
+		{
+			name: "with no webhook_url nor webhook_url_file - it fails",
+			in: `
+channel: '#general'
+`,
+			expected: errors.New("one of webhook_url or webhook_url_file must be configured"),
+		},
+		{
+			name: "with webhook_url_file and fields - it succeeds",
+			in: `
+webhook_url_file: /file
+alias: Alertmanager
+fields:
+- title: Team
+  value: '{{ .CommonLabels.team }}'
+  short: true
+`,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg RocketChatConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+		})
+	}
+}
+
 func TestTelegramConfiguration(t *testing.T) {
 	tc := []struct {
 		name     string
@@ -1043,3 +1369,105 @@ parse_mode: invalid
 func newBoolPointer(b bool) *bool {
 	return &b
 }
+
+func TestRetryConfiguration(t *testing.T) {
+	tc := []struct {
+		name     string
+		in       string
+		expected error
+	}{
+		{
+			name: "with defaults - it succeeds",
+			in: `
+url: 'http://example.com'
+retry_config:
+  max_attempts: 2
+`,
+		},
+		{
+			name: "with all fields set - it succeeds",
+			in: `
+url: 'http://example.com'
+retry_config:
+  initial_interval: 1s
+  multiplier: 2
+  max_interval: 10m
+  jitter: 0
+  retry_status_codes: [429]
+  no_retry_status_codes: [503]
+`,
+		},
+		{
+			name: "with max_interval lower than initial_interval - it fails",
+			in: `
+url: 'http://example.com'
+retry_config:
+  initial_interval: 1m
+  max_interval: 10s
+`,
+			expected: errors.New("max_interval must not be lower than initial_interval"),
+		},
+		{
+			name: "with multiplier lower than 1 - it fails",
+			in: `
+url: 'http://example.com'
+retry_config:
+  multiplier: 0.5
+`,
+			expected: errors.New("multiplier must be at least 1"),
+		},
+		{
+			name: "with invalid jitter - it fails",
+			in: `
+url: 'http://example.com'
+retry_config:
+  jitter: 2
+`,
+			expected: errors.New("jitter must be between 0 and 1"),
+		},
+		{
+			name: "with invalid status code - it fails",
+			in: `
+url: 'http://example.com'
+retry_config:
+  retry_status_codes: [1000]
+`,
+			expected: errors.New("invalid status code 1000 in retry_status_codes"),
+		},
+		{
+			name: "with status code retried and not retried - it fails",
+			in: `
+url: 'http://example.com'
+retry_config:
+  retry_status_codes: [429]
+  no_retry_status_codes: [429]
+`,
+			expected: errors.New("status code 429 is in both retry_status_codes and no_retry_status_codes"),
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg WebhookConfig
+			err := yaml.UnmarshalStrict([]byte(tt.in), &cfg)
+
+			require.Equal(t, tt.expected, err)
+			if err == nil {
+				require.NotNil(t, cfg.RetryConfig())
+			}
+		})
+	}
+
+	// The retry configuration doesn't conflict with Pushover's retry.
+	var cfg PushoverConfig
+	err := yaml.UnmarshalStrict([]byte(`
+user_key: key
+token: token
+retry: 1m
+retry_config:
+  max_attempts: 2
+`), &cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, cfg.RetryConfig().MaxAttempts)
+	require.Equal(t, DefaultRetryConfig.InitialInterval, cfg.RetryConfig().InitialInterval)
+}
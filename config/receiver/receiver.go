@@ -22,10 +22,16 @@ import (
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/discord"
 	"github.com/prometheus/alertmanager/notify/email"
+	"github.com/prometheus/alertmanager/notify/googlechat"
+	"github.com/prometheus/alertmanager/notify/jira"
+	"github.com/prometheus/alertmanager/notify/matrix"
+	"github.com/prometheus/alertmanager/notify/mattermost"
 	"github.com/prometheus/alertmanager/notify/msteams"
 	"github.com/prometheus/alertmanager/notify/opsgenie"
 	"github.com/prometheus/alertmanager/notify/pagerduty"
 	"github.com/prometheus/alertmanager/notify/pushover"
+	"github.com/prometheus/alertmanager/notify/rocketchat"
+	"github.com/prometheus/alertmanager/notify/servicenow"
 	"github.com/prometheus/alertmanager/notify/slack"
 	"github.com/prometheus/alertmanager/notify/sns"
 	"github.com/prometheus/alertmanager/notify/telegram"
@@ -92,6 +98,24 @@ func BuildReceiverIntegrations(nc config.Receiver, tmpl *template.Template, logg
 	for i, c := range nc.MSTeamsConfigs {
 		add("msteams", i, c, func(l log.Logger) (notify.Notifier, error) { return msteams.New(c, tmpl, l, httpOpts...) })
 	}
+	for i, c := range nc.JiraConfigs {
+		add("jira", i, c, func(l log.Logger) (notify.Notifier, error) { return jira.New(c, tmpl, l, httpOpts...) })
+	}
+	for i, c := range nc.ServiceNowConfigs {
+		add("servicenow", i, c, func(l log.Logger) (notify.Notifier, error) { return servicenow.New(c, tmpl, l, httpOpts...) })
+	}
+	for i, c := range nc.GoogleChatConfigs {
+		add("googlechat", i, c, func(l log.Logger) (notify.Notifier, error) { return googlechat.New(c, tmpl, l, httpOpts...) })
+	}
+	for i, c := range nc.MatrixConfigs {
+		add("matrix", i, c, func(l log.Logger) (notify.Notifier, error) { return matrix.New(c, tmpl, l, httpOpts...) })
+	}
+	for i, c := range nc.MattermostConfigs {
+		add("mattermost", i, c, func(l log.Logger) (notify.Notifier, error) { return mattermost.New(c, tmpl, l, httpOpts...) })
+	}
+	for i, c := range nc.RocketChatConfigs {
+		add("rocketchat", i, c, func(l log.Logger) (notify.Notifier, error) { return rocketchat.New(c, tmpl, l, httpOpts...) })
+	}
 
 	if errs.Len() > 0 {
 		return nil, &errs
@@ -26,6 +26,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/store"
@@ -37,6 +38,7 @@ type DispatcherMetrics struct {
 	aggrGroups            prometheus.Gauge
 	processingDuration    prometheus.Summary
 	aggrGroupLimitReached prometheus.Counter
+	alertLimitReached     prometheus.Counter
 }
 
 // NewDispatcherMetrics returns a new registered DispatchMetrics.
@@ -60,12 +62,18 @@ func NewDispatcherMetrics(registerLimitMetrics bool, r prometheus.Registerer) *D
 				Help: "Number of times when dispatcher failed to create new aggregation group due to limit.",
 			},
 		),
+		alertLimitReached: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "alertmanager_dispatcher_aggregation_group_alert_limit_reached_total",
+				Help: "Number of times when dispatcher failed to add an alert to an aggregation group due to limit.",
+			},
+		),
 	}
 
 	if r != nil {
 		r.MustRegister(m.aggrGroups, m.processingDuration)
 		if registerLimitMetrics {
-			r.MustRegister(m.aggrGroupLimitReached)
+			r.MustRegister(m.aggrGroupLimitReached, m.alertLimitReached)
 		}
 	}
 
@@ -80,12 +88,17 @@ type Dispatcher struct {
 	stage   notify.Stage
 	metrics *DispatcherMetrics
 	limits  Limits
+	marker  types.Marker
+	nflog   notify.NotificationLog
 
 	timeout func(time.Duration) time.Duration
 
 	mtx                sync.RWMutex
 	aggrGroupsPerRoute map[*Route]map[model.Fingerprint]*aggrGroup
 	aggrGroupsNum      int
+	// limitedAlerts holds the alerts that could not be added to an
+	// aggregation group of a route because of a limit.
+	limitedAlerts map[*Route]map[model.Fingerprint]*types.Alert
 
 	done   chan struct{}
 	ctx    context.Context
@@ -100,6 +113,18 @@ type Limits interface {
 	// 0 or negative value = unlimited.
 	// If dispatcher hits this limit, it will not create additional groups, but will log an error instead.
 	MaxNumberOfAggregationGroups() int
+	// MaxNumberOfAlertsPerAggregationGroup returns max number of alerts that a single aggregation group can hold.
+	// 0 or negative value = unlimited. Limits set on the route take precedence.
+	// If dispatcher hits this limit, it will not add the alert to the group.
+	MaxNumberOfAlertsPerAggregationGroup() int
+}
+
+// NewConfigLimits returns the Limits defined by the given configuration.
+func NewConfigLimits(c *config.Limits) Limits {
+	if c == nil {
+		return nilLimits{}
+	}
+	return configLimits{c: c}
 }
 
 // NewDispatcher returns a new Dispatcher.
@@ -110,6 +135,7 @@ func NewDispatcher(
 	mk types.Marker,
 	to func(time.Duration) time.Duration,
 	lim Limits,
+	nl notify.NotificationLog,
 	l log.Logger,
 	m *DispatcherMetrics,
 ) *Dispatcher {
@@ -125,6 +151,8 @@ func NewDispatcher(
 		logger:  log.With(l, "component", "dispatcher"),
 		metrics: m,
 		limits:  lim,
+		marker:  mk,
+		nflog:   nl,
 	}
 	return disp
 }
@@ -136,6 +164,7 @@ func (d *Dispatcher) Run() {
 	d.mtx.Lock()
 	d.aggrGroupsPerRoute = map[*Route]map[model.Fingerprint]*aggrGroup{}
 	d.aggrGroupsNum = 0
+	d.limitedAlerts = map[*Route]map[model.Fingerprint]*types.Alert{}
 	d.metrics.aggrGroups.Set(0)
 	d.ctx, d.cancel = context.WithCancel(context.Background())
 	d.mtx.Unlock()
@@ -189,6 +218,17 @@ func (d *Dispatcher) run(it provider.AlertIterator) {
 				}
 			}
 
+			for route, alerts := range d.limitedAlerts {
+				for fp, a := range alerts {
+					if a.Resolved() {
+						delete(alerts, fp)
+					}
+				}
+				if len(alerts) == 0 {
+					delete(d.limitedAlerts, route)
+				}
+			}
+
 			d.mtx.Unlock()
 
 		case <-d.ctx.Done():
@@ -319,18 +359,37 @@ func (d *Dispatcher) processAlert(alert *types.Alert, route *Route) {
 
 	ag, ok := routeGroups[fp]
 	if ok {
+		limit := route.RouteOpts.MaxAlertsPerGroup
+		if limit <= 0 {
+			limit = d.limits.MaxNumberOfAlertsPerAggregationGroup()
+		}
+		if limit > 0 && !ag.contains(alert.Fingerprint()) && ag.len() >= limit {
+			d.metrics.alertLimitReached.Inc()
+			d.setLimited(route, alert)
+			level.Error(d.logger).Log("msg", "Too many alerts in aggregation group, cannot add alert", "aggrGroup", ag, "limit", limit, "alert", alert.Name())
+			return
+		}
+		d.unsetLimited(route, alert)
 		ag.insert(alert)
 		return
 	}
 
-	// If the group does not exist, create it. But check the limit first.
+	// If the group does not exist, create it. But check the limits first.
 	if limit := d.limits.MaxNumberOfAggregationGroups(); limit > 0 && d.aggrGroupsNum >= limit {
 		d.metrics.aggrGroupLimitReached.Inc()
+		d.setLimited(route, alert)
 		level.Error(d.logger).Log("msg", "Too many aggregation groups, cannot create new group for alert", "groups", d.aggrGroupsNum, "limit", limit, "alert", alert.Name())
 		return
 	}
+	if limit := route.RouteOpts.MaxAggregationGroups; limit > 0 && len(routeGroups) >= limit {
+		d.metrics.aggrGroupLimitReached.Inc()
+		d.setLimited(route, alert)
+		level.Error(d.logger).Log("msg", "Too many aggregation groups for route, cannot create new group for alert", "route", route.Key(), "groups", len(routeGroups), "limit", limit, "alert", alert.Name())
+		return
+	}
+	d.unsetLimited(route, alert)
 
-	ag = newAggrGroup(d.ctx, groupLabels, route, d.timeout, d.logger)
+	ag = newAggrGroup(d.ctx, groupLabels, route, d.timeout, d.marker, d.nflog, d.logger)
 	routeGroups[fp] = ag
 	d.aggrGroupsNum++
 	d.metrics.aggrGroups.Inc()
@@ -356,6 +415,47 @@ func (d *Dispatcher) processAlert(alert *types.Alert, route *Route) {
 	})
 }
 
+// setLimited records that the alert could not be added to an aggregation
+// group of the route. It must be called with d.mtx held.
+func (d *Dispatcher) setLimited(route *Route, alert *types.Alert) {
+	alerts, ok := d.limitedAlerts[route]
+	if !ok {
+		alerts = map[model.Fingerprint]*types.Alert{}
+		d.limitedAlerts[route] = alerts
+	}
+	alerts[alert.Fingerprint()] = alert
+}
+
+// unsetLimited removes the alert from the limited alerts of the route. It
+// must be called with d.mtx held.
+func (d *Dispatcher) unsetLimited(route *Route, alert *types.Alert) {
+	if alerts, ok := d.limitedAlerts[route]; ok {
+		delete(alerts, alert.Fingerprint())
+	}
+}
+
+// LimitedAlerts returns the receivers of the routes for which alerts could
+// not be added to an aggregation group because of a limit, by alert
+// fingerprint.
+func (d *Dispatcher) LimitedAlerts() map[model.Fingerprint][]string {
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+
+	receivers := map[model.Fingerprint][]string{}
+	for route, alerts := range d.limitedAlerts {
+		for fp, a := range alerts {
+			if a.Resolved() {
+				continue
+			}
+			receivers[fp] = append(receivers[fp], route.RouteOpts.Receiver)
+		}
+	}
+	for fp := range receivers {
+		sort.Strings(receivers[fp])
+	}
+	return receivers
+}
+
 func getGroupLabels(alert *types.Alert, route *Route) model.LabelSet {
 	groupLabels := model.LabelSet{}
 	for ln, lv := range alert.Labels {
@@ -385,10 +485,21 @@ type aggrGroup struct {
 
 	mtx        sync.RWMutex
 	hasFlushed bool
+
+	// marker is used to skip the silenced and inhibited alerts when
+	// escalating, and nflog to share the escalation state with the peers.
+	// They may be nil.
+	marker types.Marker
+	nflog  notify.NotificationLog
+	// escalationStart is the time at which the escalation started, or the
+	// zero time if the group is not escalating, and escalationSteps the
+	// number of steps reached. They are only accessed from run().
+	escalationStart time.Time
+	escalationSteps int
 }
 
 // newAggrGroup returns a new aggregation group.
-func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, to func(time.Duration) time.Duration, logger log.Logger) *aggrGroup {
+func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, to func(time.Duration) time.Duration, mk types.Marker, nl notify.NotificationLog, logger log.Logger) *aggrGroup {
 	if to == nil {
 		to = func(d time.Duration) time.Duration { return d }
 	}
@@ -399,6 +510,8 @@ func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, to func(
 		timeout:  to,
 		alerts:   store.NewAlerts(),
 		done:     make(chan struct{}),
+		marker:   mk,
+		nflog:    nl,
 	}
 	ag.ctx, ag.cancel = context.WithCancel(ctx)
 
@@ -455,9 +568,23 @@ func (ag *aggrGroup) run(nf notifyFunc) {
 			ag.mtx.Unlock()
 
 			ag.flush(func(alerts ...*types.Alert) bool {
-				return nf(ctx, alerts...)
+				ok := nf(ctx, alerts...)
+				// Keep the alerts of a failed escalation step so that it is
+				// retried with the next flush.
+				if len(ag.opts.Escalation) > 0 && !ag.escalate(ctx, now, nf, alerts...) {
+					ok = false
+				}
+				return ok
 			})
 
+			// Wake up early if an escalation step is due before the next
+			// regular flush.
+			if d, ok := ag.nextEscalation(now); ok && d < ag.opts.GroupInterval {
+				ag.mtx.Lock()
+				ag.next.Reset(d)
+				ag.mtx.Unlock()
+			}
+
 			cancel()
 
 		case <-ag.ctx.Done():
@@ -492,6 +619,15 @@ func (ag *aggrGroup) empty() bool {
 	return ag.alerts.Empty()
 }
 
+func (ag *aggrGroup) len() int {
+	return ag.alerts.Len()
+}
+
+func (ag *aggrGroup) contains(fp model.Fingerprint) bool {
+	_, err := ag.alerts.Get(fp)
+	return err == nil
+}
+
 // flush sends notifications for all new alerts.
 func (ag *aggrGroup) flush(notify func(...*types.Alert) bool) {
 	if ag.empty() {
@@ -538,3 +674,13 @@ func (ag *aggrGroup) flush(notify func(...*types.Alert) bool) {
 type nilLimits struct{}
 
 func (n nilLimits) MaxNumberOfAggregationGroups() int { return 0 }
+
+func (n nilLimits) MaxNumberOfAlertsPerAggregationGroup() int { return 0 }
+
+type configLimits struct {
+	c *config.Limits
+}
+
+func (l configLimits) MaxNumberOfAggregationGroups() int { return l.c.MaxAggregationGroups }
+
+func (l configLimits) MaxNumberOfAlertsPerAggregationGroup() int { return l.c.MaxAlertsPerGroup }
@@ -138,7 +138,7 @@ func TestAggrGroup(t *testing.T) {
 	}
 
 	// Test regular situation where we wait for group_wait to send out alerts.
-	ag := newAggrGroup(context.Background(), lset, route, nil, log.NewNopLogger())
+	ag := newAggrGroup(context.Background(), lset, route, nil, nil, nil, log.NewNopLogger())
 	go ag.run(ntfy)
 
 	ag.insert(a1)
@@ -192,7 +192,7 @@ func TestAggrGroup(t *testing.T) {
 	// immediate flushing.
 	// Finally, set all alerts to be resolved. After successful notify the aggregation group
 	// should empty itself.
-	ag = newAggrGroup(context.Background(), lset, route, nil, log.NewNopLogger())
+	ag = newAggrGroup(context.Background(), lset, route, nil, nil, nil, log.NewNopLogger())
 	go ag.run(ntfy)
 
 	ag.insert(a1)
@@ -374,7 +374,7 @@ route:
 
 	timeout := func(d time.Duration) time.Duration { return time.Duration(0) }
 	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
-	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, nil, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
 	go dispatcher.Run()
 	defer dispatcher.Stop()
 
@@ -514,7 +514,7 @@ route:
 	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
 	lim := limits{groups: 6}
 	m := NewDispatcherMetrics(true, prometheus.NewRegistry())
-	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, lim, logger, m)
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, lim, nil, logger, m)
 	go dispatcher.Run()
 	defer dispatcher.Stop()
 
@@ -568,6 +568,107 @@ route:
 	require.Len(t, alertGroups, 6)
 }
 
+func TestGroupsWithConfigLimits(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+- name: 'testing'
+
+limits:
+  max_alerts_per_group: 2
+
+route:
+  group_by: ['alertname']
+  group_wait: 10ms
+  group_interval: 10ms
+  receiver: 'prod'
+  routes:
+  - match:
+      env: 'testing'
+    receiver: 'testing'
+    limits:
+      max_aggregation_groups: 1`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, nil, logger, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	timeout := func(d time.Duration) time.Duration { return time.Duration(0) }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	m := NewDispatcherMetrics(true, prometheus.NewRegistry())
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, NewConfigLimits(conf.Limits), nil, logger, m)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	inputAlerts := []*types.Alert{
+		// Matches the root route, the third alert exceeds the limit of alerts per group.
+		newAlert(model.LabelSet{"alertname": "OtherAlert", "instance": "inst1"}),
+		newAlert(model.LabelSet{"alertname": "OtherAlert", "instance": "inst2"}),
+		newAlert(model.LabelSet{"alertname": "OtherAlert", "instance": "inst3"}),
+		// Matches the sub-route, the second alert exceeds the limit of groups of the route.
+		newAlert(model.LabelSet{"env": "testing", "alertname": "TestingAlert"}),
+		newAlert(model.LabelSet{"env": "testing", "alertname": "OtherTestingAlert"}),
+	}
+	routeFilter := func(*Route) bool { return true }
+	alertFilter := func(*types.Alert, time.Time) bool { return true }
+
+	// Wait for each alert to be processed before putting the next one so
+	// that the alerts exceeding the limits are deterministic.
+	for _, a := range inputAlerts {
+		require.NoError(t, alerts.Put(a))
+		require.Eventually(t, func() bool {
+			if _, ok := dispatcher.LimitedAlerts()[a.Fingerprint()]; ok {
+				return true
+			}
+			alertGroups, _ := dispatcher.Groups(routeFilter, alertFilter)
+			for _, ag := range alertGroups {
+				for _, ga := range ag.Alerts {
+					if ga.Fingerprint() == a.Fingerprint() {
+						return true
+					}
+				}
+			}
+			return false
+		}, 2*time.Second, 10*time.Millisecond)
+	}
+	require.Equal(t, 1.0, testutil.ToFloat64(m.alertLimitReached))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.aggrGroupLimitReached))
+
+	alertGroups, _ := dispatcher.Groups(routeFilter, alertFilter)
+	require.Len(t, alertGroups, 2)
+
+	require.Equal(t, map[model.Fingerprint][]string{
+		inputAlerts[2].Fingerprint(): {"prod"},
+		inputAlerts[4].Fingerprint(): {"testing"},
+	}, dispatcher.LimitedAlerts())
+
+	// An alert already in the group can still be updated.
+	updated := *inputAlerts[0]
+	updated.Annotations = model.LabelSet{"foo": "baz"}
+	require.NoError(t, alerts.Put(&updated))
+
+	require.Eventually(t, func() bool {
+		alertGroups, _ := dispatcher.Groups(routeFilter, alertFilter)
+		for _, ag := range alertGroups {
+			for _, a := range ag.Alerts {
+				if a.Annotations["foo"] == "baz" {
+					return true
+				}
+			}
+		}
+		return false
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.alertLimitReached))
+}
+
 type recordStage struct {
 	mtx    sync.RWMutex
 	alerts map[string]map[model.Fingerprint]*types.Alert
@@ -632,7 +733,7 @@ func TestDispatcherRace(t *testing.T) {
 	defer alerts.Close()
 
 	timeout := func(d time.Duration) time.Duration { return time.Duration(0) }
-	dispatcher := NewDispatcher(alerts, nil, nil, marker, timeout, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
+	dispatcher := NewDispatcher(alerts, nil, nil, marker, timeout, nil, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
 	go dispatcher.Run()
 	dispatcher.Stop()
 }
@@ -660,7 +761,7 @@ func TestDispatcherRaceOnFirstAlertNotDeliveredWhenGroupWaitIsZero(t *testing.T)
 
 	timeout := func(d time.Duration) time.Duration { return d }
 	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
-	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, nil, nil, logger, NewDispatcherMetrics(false, prometheus.NewRegistry()))
 	go dispatcher.Run()
 	defer dispatcher.Stop()
 
@@ -686,8 +787,13 @@ func TestDispatcherRaceOnFirstAlertNotDeliveredWhenGroupWaitIsZero(t *testing.T)
 
 type limits struct {
 	groups int
+	alerts int
 }
 
 func (l limits) MaxNumberOfAggregationGroups() int {
 	return l.groups
 }
+
+func (l limits) MaxNumberOfAlertsPerAggregationGroup() int {
+	return l.alerts
+}
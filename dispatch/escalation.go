@@ -0,0 +1,169 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kit/log/level"
+
+	"github.com/prometheus/alertmanager/nflog"
+	"github.com/prometheus/alertmanager/nflog/nflogpb"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// EscalationIntegration is the integration name of the notification log
+// entries holding the escalation state of aggregation groups.
+const EscalationIntegration = "escalation"
+
+// escalationReceiver returns the notification log receiver of an entry of the
+// escalation state. The start of the escalation is logged under the receiver
+// of the route with index 0, an entry without firing alerts meaning that the
+// escalation was stopped. The i-th step reached is logged under the receiver
+// of the step with index i+1.
+func escalationReceiver(name string, idx int) *nflogpb.Receiver {
+	return &nflogpb.Receiver{
+		GroupName:   name,
+		Integration: EscalationIntegration,
+		Idx:         uint32(idx),
+	}
+}
+
+// escalate starts, continues or stops the escalation of the group after the
+// given alerts have been flushed. The receivers of the steps which were
+// reached, or whose delay has passed, are notified about the alerts. It
+// returns false if notifying any of them failed.
+//
+// The escalation starts with the first flush in which an alert of the group
+// is active, and stops once they are all resolved, silenced or inhibited.
+func (ag *aggrGroup) escalate(ctx context.Context, now time.Time, nf notifyFunc, alerts ...*types.Alert) bool {
+	var firing []uint64
+	for _, a := range alerts {
+		if a.Resolved() {
+			continue
+		}
+		// Silenced and inhibited alerts don't escalate.
+		if ag.marker != nil && !ag.marker.Active(a.Fingerprint()) {
+			continue
+		}
+		firing = append(firing, uint64(a.Fingerprint()))
+	}
+
+	if len(firing) == 0 {
+		if ag.escalationStart.IsZero() {
+			return true
+		}
+		// Let the receivers of the steps that were reached know about the
+		// resolved alerts before stopping.
+		if !ag.notifyEscalationSteps(ctx, now, nf, nil, alerts...) {
+			return false
+		}
+		ag.escalationStart, ag.escalationSteps = time.Time{}, 0
+		ag.logEscalation(escalationReceiver(ag.opts.Receiver, 0), nil)
+		return true
+	}
+
+	if ag.escalationStart.IsZero() {
+		// The group may be resuming an escalation started before a restart
+		// or a reload of the configuration, or by a peer.
+		ag.loadEscalation()
+	}
+	if ag.escalationStart.IsZero() {
+		ag.escalationStart = now
+		ag.logEscalation(escalationReceiver(ag.opts.Receiver, 0), firing)
+	}
+	return ag.notifyEscalationSteps(ctx, now, nf, firing, alerts...)
+}
+
+// notifyEscalationSteps notifies the receivers of the steps which were
+// reached, and of the steps whose delay has passed if some alerts are firing.
+func (ag *aggrGroup) notifyEscalationSteps(ctx context.Context, now time.Time, nf notifyFunc, firing []uint64, alerts ...*types.Alert) bool {
+	ok := true
+	for i, step := range ag.opts.Escalation {
+		reached := i < ag.escalationSteps
+		if !reached && (len(firing) == 0 || now.Before(ag.escalationStart.Add(step.Delay))) {
+			break
+		}
+		if !nf(notify.WithReceiverName(ctx, step.Receiver), alerts...) {
+			ok = false
+			continue
+		}
+		if !reached {
+			ag.escalationSteps = i + 1
+			ag.logEscalation(escalationReceiver(step.Receiver, i+1), firing)
+		}
+	}
+	return ok
+}
+
+// nextEscalation returns the time until the next escalation step is due, if
+// the group is escalating.
+func (ag *aggrGroup) nextEscalation(now time.Time) (time.Duration, bool) {
+	if ag.escalationStart.IsZero() || ag.escalationSteps >= len(ag.opts.Escalation) {
+		return 0, false
+	}
+	if due := ag.escalationStart.Add(ag.opts.Escalation[ag.escalationSteps].Delay); due.After(now) {
+		return due.Sub(now), true
+	}
+	return 0, false
+}
+
+// loadEscalation restores the escalation state of the group from the
+// notification log.
+func (ag *aggrGroup) loadEscalation() {
+	if ag.nflog == nil {
+		return
+	}
+	e, err := ag.queryEscalation(escalationReceiver(ag.opts.Receiver, 0))
+	if err != nil || e == nil || len(e.FiringAlerts) == 0 {
+		return
+	}
+	ag.escalationStart = e.Timestamp
+	for i, step := range ag.opts.Escalation {
+		e, err := ag.queryEscalation(escalationReceiver(step.Receiver, i+1))
+		if err != nil {
+			return
+		}
+		// Entries logged before the start belong to a previous escalation.
+		if e != nil && !e.Timestamp.Before(ag.escalationStart) {
+			ag.escalationSteps = i + 1
+		}
+	}
+}
+
+// queryEscalation returns the entry of the escalation state logged under the
+// receiver, or nil if there is none.
+func (ag *aggrGroup) queryEscalation(r *nflogpb.Receiver) (*nflogpb.Entry, error) {
+	entries, err := ag.nflog.Query(nflog.QGroupKey(ag.GroupKey()), nflog.QReceiver(r))
+	if errors.Is(err, nflog.ErrNotFound) || (err == nil && len(entries) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		level.Error(ag.logger).Log("msg", "Failed to query escalation state", "err", err)
+		return nil, err
+	}
+	return entries[0], nil
+}
+
+func (ag *aggrGroup) logEscalation(r *nflogpb.Receiver, firing []uint64) {
+	if ag.nflog == nil {
+		return
+	}
+	if err := ag.nflog.Log(r, ag.GroupKey(), firing, nil, 0); err != nil {
+		level.Error(ag.logger).Log("msg", "Failed to log escalation state", "err", err)
+	}
+}
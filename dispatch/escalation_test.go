@@ -0,0 +1,270 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/nflog"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newEscalationAggrGroup(mk types.Marker, nl notify.NotificationLog) *aggrGroup {
+	route := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:       "team",
+			GroupBy:        map[model.LabelName]struct{}{"a": {}},
+			GroupWait:      10 * time.Millisecond,
+			GroupInterval:  300 * time.Millisecond,
+			RepeatInterval: time.Hour,
+			Escalation: []EscalationStep{
+				{Delay: 50 * time.Millisecond, Receiver: "primary"},
+				{Delay: 150 * time.Millisecond, Receiver: "secondary"},
+			},
+		},
+	}
+	return newAggrGroup(context.Background(), model.LabelSet{"a": "v1"}, route, nil, mk, nl, log.NewNopLogger())
+}
+
+func newEscalationTestGroup(t *testing.T, mk types.Marker) (*aggrGroup, chan string) {
+	t.Helper()
+
+	receivers := make(chan string, 100)
+	ag := newEscalationAggrGroup(mk, nil)
+	go ag.run(func(ctx context.Context, alerts ...*types.Alert) bool {
+		rcv, _ := notify.ReceiverName(ctx)
+		receivers <- rcv
+		return true
+	})
+	t.Cleanup(ag.stop)
+
+	return ag, receivers
+}
+
+func expectReceivers(t *testing.T, ch chan string, expected ...string) {
+	t.Helper()
+
+	for _, exp := range expected {
+		select {
+		case rcv := <-ch:
+			require.Equal(t, exp, rcv)
+		case <-time.After(time.Second):
+			t.Fatalf("expected notification for %q", exp)
+		}
+	}
+}
+
+func TestAggrGroupEscalation(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	ag, receivers := newEscalationTestGroup(t, marker)
+
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "v1"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	}
+	marker.SetActiveOrSilenced(a.Fingerprint(), 0, nil, nil)
+	ag.insert(a)
+
+	// The steps are notified in order once their delay has passed, without
+	// waiting for the group interval.
+	start := time.Now()
+	expectReceivers(t, receivers, "team")
+	expectReceivers(t, receivers, "team", "primary")
+	require.Less(t, time.Since(start), ag.opts.GroupInterval)
+	expectReceivers(t, receivers, "team", "primary", "secondary")
+
+	// Resolving the alert notifies all the receivers and stops the escalation.
+	resolved := *a
+	resolved.EndsAt = time.Now().Add(-time.Second)
+	resolved.UpdatedAt = time.Now()
+	ag.insert(&resolved)
+
+	expectReceivers(t, receivers, "team", "primary", "secondary")
+
+	// The group is empty once the resolved notifications succeeded.
+	select {
+	case rcv := <-receivers:
+		t.Fatalf("unexpected notification for %q", rcv)
+	case <-time.After(400 * time.Millisecond):
+	}
+}
+
+func TestAggrGroupEscalationSilenced(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	ag, receivers := newEscalationTestGroup(t, marker)
+
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "v1"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	}
+	marker.SetActiveOrSilenced(a.Fingerprint(), 0, []string{"silence"}, nil)
+	ag.insert(a)
+
+	expectReceivers(t, receivers, "team")
+	select {
+	case rcv := <-receivers:
+		t.Fatalf("unexpected notification for %q", rcv)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func newEscalationRecorder() (*[]string, notifyFunc) {
+	var receivers []string
+	return &receivers, func(ctx context.Context, _ ...*types.Alert) bool {
+		rcv, _ := notify.ReceiverName(ctx)
+		receivers = append(receivers, rcv)
+		return true
+	}
+}
+
+func TestAggrGroupEscalationSilenceExpiry(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	ag := newEscalationAggrGroup(marker, nil)
+	receivers, nf := newEscalationRecorder()
+
+	now := time.Now()
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "v1"},
+			StartsAt: now.Add(-time.Hour),
+		},
+		UpdatedAt: now,
+	}
+	marker.SetActiveOrSilenced(a.Fingerprint(), 0, nil, nil)
+
+	require.True(t, ag.escalate(context.Background(), now, nf, a))
+	require.True(t, ag.escalate(context.Background(), now.Add(100*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary"}, *receivers)
+
+	// The silenced alert doesn't escalate, and the escalation stops.
+	marker.SetActiveOrSilenced(a.Fingerprint(), 0, []string{"silence"}, nil)
+	*receivers = nil
+	require.True(t, ag.escalate(context.Background(), now.Add(time.Second), nf, a))
+	require.Equal(t, []string{"primary"}, *receivers)
+	require.True(t, ag.escalationStart.IsZero())
+
+	// Once the silence expires, the escalation starts over instead of
+	// jumping to the last step.
+	marker.SetActiveOrSilenced(a.Fingerprint(), 0, nil, nil)
+	*receivers = nil
+	expired := now.Add(3 * time.Hour)
+	require.True(t, ag.escalate(context.Background(), expired, nf, a))
+	require.Empty(t, *receivers)
+	require.True(t, ag.escalate(context.Background(), expired.Add(100*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary"}, *receivers)
+}
+
+func TestAggrGroupEscalationReload(t *testing.T) {
+	nl, err := nflog.New(nflog.Options{Retention: time.Hour})
+	require.NoError(t, err)
+	receivers, nf := newEscalationRecorder()
+
+	now := time.Now()
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "v1"},
+			StartsAt: now.Add(-time.Hour),
+		},
+		UpdatedAt: now,
+	}
+
+	// An alert firing before the route had an escalation doesn't reach all
+	// the steps at once.
+	ag := newEscalationAggrGroup(nil, nl)
+	require.True(t, ag.escalate(context.Background(), now, nf, a))
+	require.Empty(t, *receivers)
+	require.True(t, ag.escalate(context.Background(), now.Add(100*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary"}, *receivers)
+
+	// The group created after a reload of the configuration resumes the
+	// escalation from the notification log.
+	*receivers = nil
+	ag = newEscalationAggrGroup(nil, nl)
+	require.True(t, ag.escalate(context.Background(), now.Add(100*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary"}, *receivers)
+	require.Equal(t, 1, ag.escalationSteps)
+	require.True(t, ag.escalate(context.Background(), time.Now().Add(200*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary", "primary", "secondary"}, *receivers)
+
+	// Once resolved, the escalation is stopped for the peers as well.
+	*receivers = nil
+	resolved := *a
+	resolved.EndsAt = now
+	require.True(t, ag.escalate(context.Background(), time.Now(), nf, &resolved))
+	require.Equal(t, []string{"primary", "secondary"}, *receivers)
+	ag = newEscalationAggrGroup(nil, nl)
+	ag.loadEscalation()
+	require.True(t, ag.escalationStart.IsZero())
+	require.Zero(t, ag.escalationSteps)
+}
+
+func TestAggrGroupEscalationRetry(t *testing.T) {
+	ag := newEscalationAggrGroup(nil, nil)
+
+	var (
+		receivers []string
+		failed    bool
+	)
+	nf := func(ctx context.Context, _ ...*types.Alert) bool {
+		rcv, _ := notify.ReceiverName(ctx)
+		receivers = append(receivers, rcv)
+		// The first notification of the primary receiver fails.
+		if rcv == "primary" && !failed {
+			failed = true
+			return false
+		}
+		return true
+	}
+
+	start := time.Now().Add(-time.Minute)
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "v1"},
+			StartsAt: start,
+		},
+		UpdatedAt: start,
+	}
+
+	require.True(t, ag.escalate(context.Background(), start, nf, a))
+	require.False(t, ag.escalate(context.Background(), start.Add(100*time.Millisecond), nf, a))
+	require.Zero(t, ag.escalationSteps)
+	require.True(t, ag.escalate(context.Background(), start.Add(100*time.Millisecond), nf, a))
+	require.Equal(t, []string{"primary", "primary"}, receivers)
+	require.Equal(t, 1, ag.escalationSteps)
+
+	// A failed resolved notification keeps the escalation going.
+	failed = false
+	resolved := *a
+	resolved.EndsAt = start.Add(time.Second)
+	require.False(t, ag.escalate(context.Background(), start.Add(2*time.Second), nf, &resolved))
+	require.False(t, ag.escalationStart.IsZero())
+	require.True(t, ag.escalate(context.Background(), start.Add(2*time.Second), nf, &resolved))
+	require.True(t, ag.escalationStart.IsZero())
+}
@@ -88,6 +88,14 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 	if cr.RepeatInterval != nil {
 		opts.RepeatInterval = time.Duration(*cr.RepeatInterval)
 	}
+	// Limits are not inherited: each route that inherited them would get its
+	// own budget, multiplying the limit of the parent by its number of
+	// children.
+	opts.MaxAggregationGroups, opts.MaxAlertsPerGroup = 0, 0
+	if cr.Limits != nil {
+		opts.MaxAggregationGroups = cr.Limits.MaxAggregationGroups
+		opts.MaxAlertsPerGroup = cr.Limits.MaxAlertsPerGroup
+	}
 
 	// Build matchers.
 	var matchers labels.Matchers
@@ -120,6 +128,16 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 	opts.MuteTimeIntervals = cr.MuteTimeIntervals
 	opts.ActiveTimeIntervals = cr.ActiveTimeIntervals
 
+	opts.Escalation = nil
+	if cr.Escalation != nil {
+		for _, step := range cr.Escalation.Steps {
+			opts.Escalation = append(opts.Escalation, EscalationStep{
+				Delay:    time.Duration(step.Delay),
+				Receiver: step.Receiver,
+			})
+		}
+	}
+
 	route := &Route{
 		parent:    parent,
 		RouteOpts: opts,
@@ -234,6 +252,23 @@ type RouteOpts struct {
 
 	// A list of time intervals for which the route is active.
 	ActiveTimeIntervals []string
+
+	// The maximum number of aggregation groups of the route and of alerts
+	// per aggregation group. 0 means no limit. They are not inherited by the
+	// child routes.
+	MaxAggregationGroups int
+	MaxAlertsPerGroup    int
+
+	// Receivers notified when the alerts of an aggregation group are still
+	// firing after the delay of the step, ordered by delay.
+	Escalation []EscalationStep
+}
+
+// EscalationStep notifies a receiver once the alerts of an aggregation group
+// have been firing for the given delay.
+type EscalationStep struct {
+	Delay    time.Duration
+	Receiver string
 }
 
 func (ro *RouteOpts) String() string {
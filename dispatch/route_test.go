@@ -383,6 +383,42 @@ routes:
 	require.False(t, child2.RouteOpts.GroupByAll)
 }
 
+func TestLimitsNotInherited(t *testing.T) {
+	in := `
+routes:
+- match:
+    env: 'parent'
+  limits:
+    max_aggregation_groups: 10
+    max_alerts_per_group: 5
+
+  routes:
+  - match:
+      env: 'child1'
+
+  - match:
+      env: 'child2'
+    limits:
+      max_aggregation_groups: 2
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+
+	tree := NewRoute(&ctree, nil)
+	parent := tree.Routes[0]
+	child1 := parent.Routes[0]
+	child2 := parent.Routes[1]
+	require.Equal(t, 10, parent.RouteOpts.MaxAggregationGroups)
+	require.Equal(t, 5, parent.RouteOpts.MaxAlertsPerGroup)
+	require.Equal(t, 0, child1.RouteOpts.MaxAggregationGroups)
+	require.Equal(t, 0, child1.RouteOpts.MaxAlertsPerGroup)
+	require.Equal(t, 2, child2.RouteOpts.MaxAggregationGroups)
+	require.Equal(t, 0, child2.RouteOpts.MaxAlertsPerGroup)
+}
+
 func TestRouteMatchers(t *testing.T) {
 	in := `
 receiver: 'notify-def'
@@ -0,0 +1,258 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events fans out changes of alerts, silences and aggregation groups
+// to subscribers such as the event stream of the API.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/provider"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Type is the type of an event.
+type Type string
+
+const (
+	// TypeAlert is the type of events for alerts received or updated.
+	TypeAlert Type = "alert"
+	// TypeSilence is the type of events for silences created, updated or
+	// expired.
+	TypeSilence Type = "silence"
+	// TypeGroupFlush is the type of events for aggregation groups flushed
+	// to their receiver.
+	TypeGroupFlush Type = "group_flush"
+)
+
+// ParseType parses the type of an event.
+func ParseType(s string) (Type, error) {
+	switch t := Type(s); t {
+	case TypeAlert, TypeSilence, TypeGroupFlush:
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown event type %q", s)
+}
+
+// GroupFlush is the flush of an aggregation group.
+type GroupFlush struct {
+	Receiver    string
+	GroupKey    string
+	GroupLabels model.LabelSet
+	Alerts      []*types.Alert
+	// Success is whether the notification pipeline succeeded.
+	Success bool
+}
+
+// Event is a change of an alert, a silence or an aggregation group. Only the
+// field matching its type is set.
+type Event struct {
+	Type      Type
+	Timestamp time.Time
+
+	Alert   *types.Alert
+	Silence *pb.Silence
+	Flush   *GroupFlush
+}
+
+// Subscription receives the events published to a hub.
+type Subscription struct {
+	hub  *Hub
+	id   int
+	c    chan Event
+	done chan struct{}
+}
+
+// C returns the channel the events are delivered on.
+func (s *Subscription) C() <-chan Event {
+	return s.c
+}
+
+// Done returns a channel which is closed once the subscription was dropped by
+// the hub because it didn't keep up with the events, or was closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close removes the subscription from the hub.
+func (s *Subscription) Close() {
+	s.hub.mtx.Lock()
+	defer s.hub.mtx.Unlock()
+
+	s.hub.remove(s.id)
+}
+
+// Hub delivers the published events to all its subscriptions. Publishing
+// never blocks: subscriptions whose buffer is full are dropped.
+type Hub struct {
+	logger  log.Logger
+	metrics *metrics
+
+	mtx  sync.Mutex
+	subs map[int]*Subscription
+	next int
+}
+
+type metrics struct {
+	subscriptions        prometheus.Gauge
+	eventsTotal          *prometheus.CounterVec
+	droppedSubscriptions prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	m := &metrics{
+		subscriptions: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alertmanager_events_subscriptions",
+			Help: "Number of subscriptions to the events of alerts, silences and aggregation groups.",
+		}),
+		eventsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_events_published_total",
+			Help: "Number of events of alerts, silences and aggregation groups published.",
+		}, []string{"type"}),
+		droppedSubscriptions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_events_subscriptions_dropped_total",
+			Help: "Number of subscriptions dropped because they didn't keep up with the events.",
+		}),
+	}
+	for _, t := range []Type{TypeAlert, TypeSilence, TypeGroupFlush} {
+		m.eventsTotal.WithLabelValues(string(t))
+	}
+	if r != nil {
+		r.MustRegister(m.subscriptions, m.eventsTotal, m.droppedSubscriptions)
+	}
+	return m
+}
+
+// NewHub returns a new hub.
+func NewHub(l log.Logger, r prometheus.Registerer) *Hub {
+	if l == nil {
+		l = log.NewNopLogger()
+	}
+	return &Hub{
+		logger:  l,
+		metrics: newMetrics(r),
+		subs:    map[int]*Subscription{},
+	}
+}
+
+// Subscribe returns a subscription buffering up to size events.
+func (h *Hub) Subscribe(size int) *Subscription {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	s := &Subscription{
+		hub:  h,
+		id:   h.next,
+		c:    make(chan Event, size),
+		done: make(chan struct{}),
+	}
+	h.subs[s.id] = s
+	h.next++
+	h.metrics.subscriptions.Set(float64(len(h.subs)))
+
+	return s
+}
+
+// remove must be called with the lock held.
+func (h *Hub) remove(id int) {
+	s, ok := h.subs[id]
+	if !ok {
+		return
+	}
+	delete(h.subs, id)
+	close(s.done)
+	h.metrics.subscriptions.Set(float64(len(h.subs)))
+}
+
+// Publish delivers the event to all subscriptions.
+func (h *Hub) Publish(e Event) {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.metrics.eventsTotal.WithLabelValues(string(e.Type)).Inc()
+	for id, s := range h.subs {
+		select {
+		case s.c <- e:
+		default:
+			level.Debug(h.logger).Log("msg", "Dropping subscription which doesn't keep up with the events", "id", id)
+			h.metrics.droppedSubscriptions.Inc()
+			h.remove(id)
+		}
+	}
+}
+
+// PublishSilence publishes the change of a silence.
+func (h *Hub) PublishSilence(sil *pb.Silence) {
+	h.Publish(Event{Type: TypeSilence, Silence: sil})
+}
+
+// Run publishes the alerts received by the provider until stopc is closed.
+func (h *Hub) Run(alerts provider.Alerts, stopc <-chan struct{}) {
+	it := alerts.Subscribe()
+	defer it.Close()
+
+	for {
+		select {
+		case a, ok := <-it.Next():
+			if !ok {
+				if err := it.Err(); err != nil {
+					level.Error(h.logger).Log("msg", "Error on alert update", "err", err)
+				}
+				return
+			}
+			h.Publish(Event{Type: TypeAlert, Alert: a})
+		case <-stopc:
+			return
+		}
+	}
+}
+
+// Stage returns a stage executing s which publishes the flushes of the
+// aggregation groups.
+func (h *Hub) Stage(s notify.Stage) notify.Stage {
+	return flushStage{hub: h, next: s}
+}
+
+type flushStage struct {
+	hub  *Hub
+	next notify.Stage
+}
+
+// Exec implements the notify.Stage interface.
+func (s flushStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	f := &GroupFlush{Alerts: alerts}
+	f.Receiver, _ = notify.ReceiverName(ctx)
+	f.GroupKey, _ = notify.GroupKey(ctx)
+	f.GroupLabels, _ = notify.GroupLabels(ctx)
+
+	ctx, res, err := s.next.Exec(ctx, l, alerts...)
+	f.Success = err == nil
+	s.hub.Publish(Event{Type: TypeGroupFlush, Flush: f})
+
+	return ctx, res, err
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/notify"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestHub(t *testing.T) {
+	h := NewHub(nil, prometheus.NewRegistry())
+
+	s1 := h.Subscribe(10)
+	s2 := h.Subscribe(1)
+
+	h.PublishSilence(&pb.Silence{Id: "1"})
+	e := <-s1.C()
+	require.Equal(t, TypeSilence, e.Type)
+	require.Equal(t, "1", e.Silence.Id)
+	require.False(t, e.Timestamp.IsZero())
+
+	// The subscription which doesn't keep up is dropped.
+	h.PublishSilence(&pb.Silence{Id: "2"})
+	<-s2.Done()
+	require.Equal(t, "2", (<-s1.C()).Silence.Id)
+	require.Len(t, h.subs, 1)
+
+	s1.Close()
+	<-s1.Done()
+	require.Empty(t, h.subs)
+
+	// Closing a dropped subscription is a no-op.
+	s2.Close()
+}
+
+func TestParseType(t *testing.T) {
+	typ, err := ParseType("group_flush")
+	require.NoError(t, err)
+	require.Equal(t, TypeGroupFlush, typ)
+
+	_, err = ParseType("foo")
+	require.EqualError(t, err, `unknown event type "foo"`)
+}
+
+type stageFunc func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error)
+
+func (f stageFunc) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	return f(ctx, l, alerts...)
+}
+
+func TestStage(t *testing.T) {
+	h := NewHub(nil, nil)
+	sub := h.Subscribe(10)
+
+	fail := false
+	s := h.Stage(stageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		if fail {
+			return ctx, nil, errors.New("failed")
+		}
+		return ctx, alerts, nil
+	}))
+
+	alert := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"alertname": "a"}}}
+	ctx := notify.WithReceiverName(context.Background(), "team-X")
+	ctx = notify.WithGroupKey(ctx, "{}:{alertname=\"a\"}")
+	ctx = notify.WithGroupLabels(ctx, model.LabelSet{"alertname": "a"})
+
+	_, res, err := s.Exec(ctx, log.NewNopLogger(), alert)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Alert{alert}, res)
+
+	e := <-sub.C()
+	require.Equal(t, TypeGroupFlush, e.Type)
+	require.Equal(t, &GroupFlush{
+		Receiver:    "team-X",
+		GroupKey:    "{}:{alertname=\"a\"}",
+		GroupLabels: model.LabelSet{"alertname": "a"},
+		Alerts:      []*types.Alert{alert},
+		Success:     true,
+	}, e.Flush)
+
+	fail = true
+	_, _, err = s.Exec(ctx, log.NewNopLogger(), alert)
+	require.Error(t, err)
+	require.False(t, (<-sub.C()).Flush.Success)
+}
@@ -15,12 +15,15 @@ package inhibit
 
 import (
 	"context"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/oklog/run"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
 	"github.com/prometheus/alertmanager/config"
@@ -30,6 +33,11 @@ import (
 	"github.com/prometheus/alertmanager/types"
 )
 
+// targetsPruneInterval is the interval at which the inhibited targets of the
+// rules are checked against the alerts of the provider, to drop the ones which
+// resolved on their own or were garbage collected.
+const targetsPruneInterval = time.Minute
+
 // An Inhibitor determines whether a given label set is muted based on the
 // currently active alerts and a set of inhibition rules. It implements the
 // Muter interface.
@@ -43,28 +51,120 @@ type Inhibitor struct {
 	cancel func()
 }
 
-// NewInhibitor returns a new Inhibitor.
-func NewInhibitor(ap provider.Alerts, rs []config.InhibitRule, mk types.Marker, logger log.Logger) *Inhibitor {
+// Metrics represents metrics associated to the inhibitor. As the inhibitor is
+// recreated on configuration reloads, they are created once and passed to
+// every new inhibitor. The rules are identified by their name, or by their
+// index in the configuration if they have none.
+type Metrics struct {
+	sourceAlerts       *prometheus.Desc
+	inhibitedTargets   *prometheus.Desc
+	evaluationDuration *prometheus.HistogramVec
+
+	mtx   sync.RWMutex
+	rules []*InhibitRule
+}
+
+// NewMetrics returns a new Metrics instance. The metrics are registered if r
+// isn't nil.
+func NewMetrics(r prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		sourceAlerts: prometheus.NewDesc(
+			"alertmanager_inhibit_rule_source_alerts",
+			"Number of alerts in the source cache of an inhibit rule.",
+			[]string{"rule"}, nil,
+		),
+		inhibitedTargets: prometheus.NewDesc(
+			"alertmanager_inhibit_rule_inhibited_targets",
+			"Number of target alerts currently inhibited by an inhibit rule.",
+			[]string{"rule"}, nil,
+		),
+		evaluationDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "alertmanager_inhibit_rule_evaluation_duration_seconds",
+				Help:    "Duration of the evaluation of an inhibit rule for an alert.",
+				Buckets: []float64{.00001, .0001, .001, .01, .1},
+			},
+			[]string{"rule"},
+		),
+	}
+	if r != nil {
+		r.MustRegister(m, m.evaluationDuration)
+	}
+	return m
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.sourceAlerts
+	ch <- m.inhibitedTargets
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, r := range m.rules {
+		ch <- prometheus.MustNewConstMetric(m.sourceAlerts, prometheus.GaugeValue, float64(r.scache.Len()), r.id)
+		ch <- prometheus.MustNewConstMetric(m.inhibitedTargets, prometheus.GaugeValue, float64(len(r.Targets())), r.id)
+	}
+}
+
+// setRules replaces the rules the metrics are collected for.
+func (m *Metrics) setRules(rules []*InhibitRule) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.rules = rules
+	// Drop the series of the rules of the previous configuration.
+	m.evaluationDuration.Reset()
+	for _, r := range rules {
+		r.evaluationDuration = m.evaluationDuration.WithLabelValues(r.id)
+	}
+}
+
+// NewInhibitor returns a new Inhibitor. If m is nil, the metrics aren't
+// registered.
+func NewInhibitor(ap provider.Alerts, rs []config.InhibitRule, mk types.Marker, logger log.Logger, m *Metrics) *Inhibitor {
+	if m == nil {
+		m = NewMetrics(nil)
+	}
+
 	ih := &Inhibitor{
 		alerts: ap,
 		marker: mk,
 		logger: logger,
 	}
-	for _, cr := range rs {
+	for i, cr := range rs {
 		r := NewInhibitRule(cr)
+		r.id = cr.Name
+		if r.id == "" {
+			r.id = strconv.Itoa(i)
+		}
 		ih.rules = append(ih.rules, r)
 	}
+	m.setRules(ih.rules)
 	return ih
 }
 
+// Rules returns the inhibit rules in the order of the configuration.
+func (ih *Inhibitor) Rules() []*InhibitRule {
+	return ih.rules
+}
+
 func (ih *Inhibitor) run(ctx context.Context) {
 	it := ih.alerts.Subscribe()
 	defer it.Close()
 
+	t := time.NewTicker(targetsPruneInterval)
+	defer t.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-t.C:
+			ih.pruneTargets(time.Now())
 		case a := <-it.Next():
 			if err := it.Err(); err != nil {
 				level.Error(ih.logger).Log("msg", "Error iterating alerts", "err", err)
@@ -72,6 +172,10 @@ func (ih *Inhibitor) run(ctx context.Context) {
 			}
 			// Update the inhibition rules' cache.
 			for _, r := range ih.rules {
+				// Resolved alerts aren't inhibited anymore.
+				if a.Resolved() {
+					r.setInhibited(a.Fingerprint(), false)
+				}
 				if r.SourceMatchers.Matches(a.Labels) {
 					if err := r.scache.Set(a); err != nil {
 						level.Error(ih.logger).Log("msg", "error on set alert", "err", err)
@@ -82,6 +186,25 @@ func (ih *Inhibitor) run(ctx context.Context) {
 	}
 }
 
+// pruneTargets drops the inhibited targets of the rules which aren't firing
+// anymore.
+func (ih *Inhibitor) pruneTargets(now time.Time) {
+	for _, r := range ih.rules {
+		for _, fp := range r.Targets() {
+			if !ih.firing(fp, now) {
+				r.setInhibited(fp, false)
+			}
+		}
+	}
+}
+
+// firing returns whether the alert with the given fingerprint is known to the
+// provider and firing at the given time.
+func (ih *Inhibitor) firing(fp model.Fingerprint, now time.Time) bool {
+	a, err := ih.alerts.Get(fp)
+	return err == nil && !a.ResolvedAt(now)
+}
+
 // Run the Inhibitor's background processing.
 func (ih *Inhibitor) Run() {
 	var (
@@ -126,31 +249,134 @@ func (ih *Inhibitor) Stop() {
 // Mutes returns true iff the given label set is muted. It implements the Muter
 // interface.
 func (ih *Inhibitor) Mutes(lset model.LabelSet) bool {
-	fp := lset.Fingerprint()
+	var (
+		fp          = lset.Fingerprint()
+		now         = time.Now()
+		inhibitedBy string
+		// Resolved alerts are muted like firing ones, but they aren't
+		// accounted as inhibited targets.
+		firing = ih.firing(fp, now)
+	)
 
 	for _, r := range ih.rules {
+		if inhibitedBy != "" {
+			// The label set is only accounted to the first rule inhibiting it.
+			r.setInhibited(fp, false)
+			continue
+		}
+		start := time.Now()
 		if !r.TargetMatchers.Matches(lset) {
 			// If target side of rule doesn't match, we don't need to look any further.
+			r.evaluationDuration.Observe(time.Since(start).Seconds())
+			r.setInhibited(fp, false)
 			continue
 		}
 		// If we are here, the target side matches. If the source side matches, too, we
 		// need to exclude inhibiting alerts for which the same is true.
-		if inhibitedByFP, eq := r.hasEqual(lset, r.SourceMatchers.Matches(lset)); eq {
-			ih.marker.SetInhibited(fp, inhibitedByFP.String())
-			return true
+		inhibitedByFP, eq := r.hasEqual(lset, r.SourceMatchers.Matches(lset), now)
+		r.evaluationDuration.Observe(time.Since(start).Seconds())
+		if eq {
+			inhibitedBy = inhibitedByFP.String()
 		}
+		r.setInhibited(fp, eq && firing)
+	}
+
+	if inhibitedBy != "" {
+		ih.marker.SetInhibited(fp, inhibitedBy)
+		return true
 	}
 	ih.marker.SetInhibited(fp)
 
 	return false
 }
 
+// RuleExplanation explains whether an inhibit rule inhibits a label set.
+type RuleExplanation struct {
+	Rule *InhibitRule
+	// Index is the position of the rule in the configuration.
+	Index int
+	// Name is the optional name of the rule.
+	Name string
+	// TargetMatched is whether the target matchers of the rule match the
+	// label set. If not, the source alerts aren't evaluated.
+	TargetMatched bool
+	// Sources are the alerts of the source cache of the rule which are
+	// firing, or resolved for less than the target delay of the rule.
+	Sources []SourceExplanation
+	// Inhibited is whether one of the source alerts inhibits the label set.
+	Inhibited   bool
+	InhibitedBy model.Fingerprint
+}
+
+// SourceExplanation explains whether a source alert of an inhibit rule
+// inhibits a label set.
+type SourceExplanation struct {
+	Fingerprint model.Fingerprint
+	Labels      model.LabelSet
+	// Unequal are the names of the equal labels of the rule whose values
+	// differ between the source alert and the label set.
+	Unequal model.LabelNames
+	// TwoSided is whether the source alert is disregarded because both the
+	// label set and the source alert match the source and target matchers.
+	TwoSided bool
+	// BelowMinDuration is whether the source alert is disregarded because it
+	// hasn't been firing for the minimum source duration of the rule yet.
+	BelowMinDuration bool
+	// Inhibits is whether the source alert inhibits the label set.
+	Inhibits bool
+}
+
+// Explain returns how each inhibit rule applies to the given label set. Unlike
+// Mutes, it doesn't mark the label set as inhibited.
+func (ih *Inhibitor) Explain(lset model.LabelSet) []RuleExplanation {
+	now := time.Now()
+	res := make([]RuleExplanation, 0, len(ih.rules))
+	for i, r := range ih.rules {
+		e := RuleExplanation{
+			Rule:          r,
+			Index:         i,
+			Name:          r.Name,
+			TargetMatched: r.TargetMatchers.Matches(lset),
+		}
+		if e.TargetMatched {
+			twoSided := r.SourceMatchers.Matches(lset)
+			for _, a := range r.scache.List() {
+				if a.ResolvedAt(now.Add(-r.TargetDelay)) {
+					continue
+				}
+				s := SourceExplanation{
+					Fingerprint: a.Fingerprint(),
+					Labels:      a.Labels,
+				}
+				for n := range r.Equal {
+					if a.Labels[n] != lset[n] {
+						s.Unequal = append(s.Unequal, n)
+					}
+				}
+				sort.Sort(s.Unequal)
+				s.TwoSided = twoSided && r.TargetMatchers.Matches(a.Labels)
+				s.BelowMinDuration = now.Sub(a.StartsAt) < r.SourceMinDuration
+				s.Inhibits = len(s.Unequal) == 0 && !s.TwoSided && !s.BelowMinDuration
+				e.Sources = append(e.Sources, s)
+			}
+			sort.Slice(e.Sources, func(i, j int) bool {
+				return e.Sources[i].Fingerprint < e.Sources[j].Fingerprint
+			})
+			e.InhibitedBy, e.Inhibited = r.hasEqual(lset, twoSided, now)
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
 // An InhibitRule specifies that a class of (source) alerts should inhibit
 // notifications for another class of (target) alerts if all specified matching
 // labels are equal between the two alerts. This may be used to inhibit alerts
 // from sending notifications if their meaning is logically a subset of a
 // higher-level alert.
 type InhibitRule struct {
+	// The optional name of the rule.
+	Name string
 	// The set of Filters which define the group of source alerts (which inhibit
 	// the target alerts).
 	SourceMatchers labels.Matchers
@@ -160,9 +386,22 @@ type InhibitRule struct {
 	// A set of label names whose label values need to be identical in source and
 	// target alerts in order for the inhibition to take effect.
 	Equal map[model.LabelName]struct{}
+	// How long a source alert has to be firing before it inhibits target
+	// alerts.
+	SourceMinDuration time.Duration
+	// How long target alerts stay inhibited after the source alert resolved.
+	TargetDelay time.Duration
 
 	// Cache of alerts matching source labels.
 	scache *store.Alerts
+
+	// Identifier of the rule in metrics.
+	id                 string
+	evaluationDuration prometheus.Observer
+
+	mtx sync.Mutex
+	// Fingerprints of the target alerts currently inhibited by the rule.
+	targets map[model.Fingerprint]struct{}
 }
 
 // NewInhibitRule returns a new InhibitRule based on a configuration definition.
@@ -218,23 +457,87 @@ func NewInhibitRule(cr config.InhibitRule) *InhibitRule {
 		equal[ln] = struct{}{}
 	}
 
+	// Resolved source alerts are kept as they inhibit target alerts for the
+	// target delay.
+	scache := store.NewAlerts()
+	scache.SetGCRetention(time.Duration(cr.TargetDelay))
+
 	return &InhibitRule{
-		SourceMatchers: sourcem,
-		TargetMatchers: targetm,
-		Equal:          equal,
-		scache:         store.NewAlerts(),
+		Name:              cr.Name,
+		SourceMatchers:    sourcem,
+		TargetMatchers:    targetm,
+		Equal:             equal,
+		SourceMinDuration: time.Duration(cr.SourceMinDuration),
+		TargetDelay:       time.Duration(cr.TargetDelay),
+		scache:            scache,
+		targets:           map[model.Fingerprint]struct{}{},
+	}
+}
+
+// setInhibited records whether the rule inhibits the target alert with the
+// given fingerprint.
+func (r *InhibitRule) setInhibited(fp model.Fingerprint, inhibited bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if inhibited {
+		if r.targets == nil {
+			r.targets = map[model.Fingerprint]struct{}{}
+		}
+		r.targets[fp] = struct{}{}
+	} else {
+		delete(r.targets, fp)
+	}
+}
+
+// Targets returns the fingerprints of the target alerts currently inhibited by
+// the rule, sorted.
+func (r *InhibitRule) Targets() []model.Fingerprint {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	res := make([]model.Fingerprint, 0, len(r.targets))
+	for fp := range r.targets {
+		res = append(res, fp)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+// Sources returns the source alerts which currently inhibit the matching
+// target alerts, sorted by fingerprint.
+func (r *InhibitRule) Sources() []*types.Alert {
+	now := time.Now()
+	res := []*types.Alert{}
+	for _, a := range r.scache.List() {
+		if r.isActiveSource(a, now) {
+			res = append(res, a)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Fingerprint() < res[j].Fingerprint() })
+	return res
+}
+
+// isActiveSource returns whether the source alert may inhibit target alerts at
+// the given time.
+func (r *InhibitRule) isActiveSource(a *types.Alert, now time.Time) bool {
+	// The cache might be stale and contain resolved alerts.
+	if a.ResolvedAt(now.Add(-r.TargetDelay)) {
+		return false
 	}
+	return now.Sub(a.StartsAt) >= r.SourceMinDuration
 }
 
 // hasEqual checks whether the source cache contains alerts matching the equal
 // labels for the given label set. If so, the fingerprint of one of those alerts
 // is returned. If excludeTwoSidedMatch is true, alerts that match both the
-// source and the target side of the rule are disregarded.
-func (r *InhibitRule) hasEqual(lset model.LabelSet, excludeTwoSidedMatch bool) (model.Fingerprint, bool) {
+// source and the target side of the rule are disregarded. Source alerts firing
+// for less than the minimum source duration are disregarded as well, whereas
+// resolved ones still count during the target delay.
+func (r *InhibitRule) hasEqual(lset model.LabelSet, excludeTwoSidedMatch bool, now time.Time) (model.Fingerprint, bool) {
 Outer:
 	for _, a := range r.scache.List() {
-		// The cache might be stale and contain resolved alerts.
-		if a.Resolved() {
+		if !r.isActiveSource(a, now) {
 			continue
 		}
 		for n := range r.Equal {
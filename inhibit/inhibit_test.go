@@ -14,11 +14,13 @@
 package inhibit
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/prometheus/common/model"
 
 	"github.com/prometheus/alertmanager/config"
@@ -132,7 +134,7 @@ func TestInhibitRuleHasEqual(t *testing.T) {
 			r.scache.Set(v)
 		}
 
-		if _, have := r.hasEqual(c.input, false); have != c.result {
+		if _, have := r.hasEqual(c.input, false, now); have != c.result {
 			t.Errorf("Unexpected result %t, expected %t", have, c.result)
 		}
 	}
@@ -153,7 +155,7 @@ func TestInhibitRuleMatches(t *testing.T) {
 	}
 
 	m := types.NewMarker(prometheus.NewRegistry())
-	ih := NewInhibitor(nil, []config.InhibitRule{rule1, rule2}, m, nopLogger)
+	ih := NewInhibitor(newFakeAlerts(nil), []config.InhibitRule{rule1, rule2}, m, nopLogger, nil)
 	now := time.Now()
 	// Active alert that matches the source filter of rule1.
 	sourceAlert1 := &types.Alert{
@@ -249,7 +251,7 @@ func TestInhibitRuleMatchers(t *testing.T) {
 	}
 
 	m := types.NewMarker(prometheus.NewRegistry())
-	ih := NewInhibitor(nil, []config.InhibitRule{rule1, rule2}, m, nopLogger)
+	ih := NewInhibitor(newFakeAlerts(nil), []config.InhibitRule{rule1, rule2}, m, nopLogger, nil)
 	now := time.Now()
 	// Active alert that matches the source filter of rule1.
 	sourceAlert1 := &types.Alert{
@@ -342,9 +344,16 @@ func newFakeAlerts(alerts []*types.Alert) *fakeAlerts {
 	}
 }
 
-func (f *fakeAlerts) GetPending() provider.AlertIterator          { return nil }
-func (f *fakeAlerts) Get(model.Fingerprint) (*types.Alert, error) { return nil, nil }
-func (f *fakeAlerts) Put(...*types.Alert) error                   { return nil }
+func (f *fakeAlerts) GetPending() provider.AlertIterator { return nil }
+func (f *fakeAlerts) Put(...*types.Alert) error          { return nil }
+func (f *fakeAlerts) Get(fp model.Fingerprint) (*types.Alert, error) {
+	for _, a := range f.alerts {
+		if a.Fingerprint() == fp {
+			return a, nil
+		}
+	}
+	return nil, provider.ErrNotFound
+}
 func (f *fakeAlerts) Subscribe() provider.AlertIterator {
 	ch := make(chan *types.Alert)
 	done := make(chan struct{})
@@ -452,7 +461,7 @@ func TestInhibit(t *testing.T) {
 	} {
 		ap := newFakeAlerts(tc.alerts)
 		mk := types.NewMarker(prometheus.NewRegistry())
-		inhibitor := NewInhibitor(ap, []config.InhibitRule{inhibitRule()}, mk, nopLogger)
+		inhibitor := NewInhibitor(ap, []config.InhibitRule{inhibitRule()}, mk, nopLogger, nil)
 
 		go func() {
 			for ap.finished != nil {
@@ -477,3 +486,262 @@ func TestInhibit(t *testing.T) {
 		}
 	}
 }
+
+func TestInhibitorExplain(t *testing.T) {
+	now := time.Now()
+	rules := []config.InhibitRule{
+		{
+			SourceMatch: map[string]string{"s": "1"},
+			TargetMatch: map[string]string{"t": "1"},
+			Equal:       model.LabelNames{"e", "f"},
+		},
+		{
+			SourceMatch: map[string]string{"s": "2"},
+			TargetMatch: map[string]string{"t": "2"},
+		},
+	}
+	ih := NewInhibitor(nil, rules, types.NewMarker(prometheus.NewRegistry()), nopLogger, nil)
+
+	for _, lset := range []model.LabelSet{
+		{"s": "1", "e": "a", "f": "a"},
+		{"s": "1", "e": "b", "f": "a"},
+		{"s": "1", "t": "1", "e": "a", "f": "a"},
+	} {
+		ih.rules[0].scache.Set(&types.Alert{
+			Alert: model.Alert{Labels: lset, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+		})
+	}
+	// Resolved source alerts aren't reported.
+	ih.rules[0].scache.Set(&types.Alert{
+		Alert: model.Alert{Labels: model.LabelSet{"s": "1"}, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(-time.Second)},
+	})
+
+	target := model.LabelSet{"t": "1", "e": "a", "f": "a"}
+	res := ih.Explain(target)
+	if len(res) != 2 {
+		t.Fatalf("expected 2 rule explanations, got %d", len(res))
+	}
+
+	e := res[0]
+	if !e.TargetMatched || !e.Inhibited || len(e.Sources) != 3 {
+		t.Fatalf("unexpected explanation of the first rule: %+v", e)
+	}
+	inhibiting := map[model.Fingerprint]struct{}{}
+	for _, s := range e.Sources {
+		switch s.Fingerprint {
+		case model.LabelSet{"s": "1", "e": "a", "f": "a"}.Fingerprint():
+			if !s.Inhibits || len(s.Unequal) != 0 || s.TwoSided {
+				t.Errorf("unexpected explanation of the equal source: %+v", s)
+			}
+			inhibiting[s.Fingerprint] = struct{}{}
+		case model.LabelSet{"s": "1", "e": "b", "f": "a"}.Fingerprint():
+			if s.Inhibits || len(s.Unequal) != 1 || s.Unequal[0] != "e" {
+				t.Errorf("unexpected explanation of the unequal source: %+v", s)
+			}
+		default:
+			// The source matches the target matchers, but not the other way
+			// around, so it isn't a two-sided match.
+			if !s.Inhibits || s.TwoSided {
+				t.Errorf("unexpected explanation of the source matching both sides: %+v", s)
+			}
+			inhibiting[s.Fingerprint] = struct{}{}
+		}
+	}
+	if len(inhibiting) != 2 {
+		t.Errorf("expected 2 inhibiting sources, got %d", len(inhibiting))
+	}
+	if _, ok := inhibiting[e.InhibitedBy]; !ok {
+		t.Errorf("expected inhibition by one of the inhibiting sources, got %s", e.InhibitedBy)
+	}
+
+	// A label set matching both the source and target matchers isn't
+	// inhibited by a source alert matching them as well.
+	res = ih.Explain(model.LabelSet{"s": "1", "t": "1", "e": "a", "f": "a"})
+	for _, s := range res[0].Sources {
+		if s.Labels["t"] == "1" && (!s.TwoSided || s.Inhibits) {
+			t.Errorf("expected the two-sided source to be excluded: %+v", s)
+		}
+	}
+
+	if e := res[1]; e.Index != 1 || e.TargetMatched || e.Inhibited || len(e.Sources) != 0 {
+		t.Errorf("unexpected explanation of the second rule: %+v", e)
+	}
+
+	// Explaining doesn't mark the label set.
+	if ids, _ := ih.marker.Inhibited(target.Fingerprint()); len(ids) != 0 {
+		t.Errorf("expected the label set not to be marked, got %v", ids)
+	}
+}
+
+func TestInhibitRuleTiming(t *testing.T) {
+	now := time.Now()
+	rule := config.InhibitRule{
+		SourceMatch:       map[string]string{"s": "1"},
+		TargetMatch:       map[string]string{"t": "1"},
+		SourceMinDuration: model.Duration(5 * time.Minute),
+		TargetDelay:       model.Duration(10 * time.Minute),
+	}
+	target := model.LabelSet{"t": "1"}
+	ap := newFakeAlerts([]*types.Alert{{
+		Alert: model.Alert{Labels: target, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+	}})
+	ih := NewInhibitor(ap, []config.InhibitRule{rule}, types.NewMarker(prometheus.NewRegistry()), nopLogger, nil)
+	r := ih.rules[0]
+
+	for _, tc := range []struct {
+		name     string
+		start    time.Duration
+		end      time.Duration
+		expected bool
+	}{
+		{
+			name:     "firing for less than the minimum duration",
+			start:    -time.Minute,
+			end:      time.Hour,
+			expected: false,
+		},
+		{
+			name:     "firing for longer than the minimum duration",
+			start:    -6 * time.Minute,
+			end:      time.Hour,
+			expected: true,
+		},
+		{
+			name:     "resolved within the target delay",
+			start:    -time.Hour,
+			end:      -5 * time.Minute,
+			expected: true,
+		},
+		{
+			name:     "resolved before the target delay",
+			start:    -time.Hour,
+			end:      -11 * time.Minute,
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r.scache = store.NewAlerts()
+			r.scache.Set(&types.Alert{
+				Alert: model.Alert{
+					Labels:   model.LabelSet{"s": "1"},
+					StartsAt: now.Add(tc.start),
+					EndsAt:   now.Add(tc.end),
+				},
+			})
+			if _, have := r.hasEqual(target, false, now); have != tc.expected {
+				t.Errorf("Unexpected result %t, expected %t", have, tc.expected)
+			}
+			if muted := ih.Mutes(target); muted != tc.expected {
+				t.Errorf("Unexpected mute %t, expected %t", muted, tc.expected)
+			}
+			expected := 0
+			if tc.expected {
+				expected = 1
+			}
+			if n := len(r.Targets()); n != expected {
+				t.Errorf("Unexpected number of inhibited targets %d, expected %d", n, expected)
+			}
+		})
+	}
+}
+
+func TestInhibitorMetrics(t *testing.T) {
+	now := time.Now()
+	reg := prometheus.NewRegistry()
+	m := NewMetrics(reg)
+
+	rules := []config.InhibitRule{
+		{
+			Name:        "datacenter-down",
+			SourceMatch: map[string]string{"alertname": "DatacenterDown"},
+			TargetMatch: map[string]string{"severity": "warning"},
+			Equal:       model.LabelNames{"dc"},
+		},
+		{
+			SourceMatch: map[string]string{"alertname": "ClusterDown"},
+			TargetMatch: map[string]string{"severity": "warning"},
+		},
+	}
+	target := model.LabelSet{"alertname": "HighLatency", "severity": "warning", "dc": "a"}
+	targetAlert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   target,
+			StartsAt: now.Add(-time.Minute),
+			EndsAt:   now.Add(time.Hour),
+		},
+	}
+	ap := newFakeAlerts([]*types.Alert{targetAlert})
+	ih := NewInhibitor(ap, rules, types.NewMarker(prometheus.NewRegistry()), nopLogger, m)
+	source := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "DatacenterDown", "dc": "a"},
+			StartsAt: now.Add(-time.Minute),
+			EndsAt:   now.Add(time.Hour),
+		},
+	}
+	ih.rules[0].scache.Set(source)
+
+	if !ih.Mutes(target) {
+		t.Fatalf("expected %s to be muted", target)
+	}
+	if fps := ih.rules[0].Targets(); len(fps) != 1 || fps[0] != target.Fingerprint() {
+		t.Errorf("unexpected targets of the first rule: %v", fps)
+	}
+	if srcs := ih.rules[0].Sources(); len(srcs) != 1 || srcs[0].Fingerprint() != source.Fingerprint() {
+		t.Errorf("unexpected sources of the first rule: %v", srcs)
+	}
+
+	expected := `
+# HELP alertmanager_inhibit_rule_inhibited_targets Number of target alerts currently inhibited by an inhibit rule.
+# TYPE alertmanager_inhibit_rule_inhibited_targets gauge
+alertmanager_inhibit_rule_inhibited_targets{rule="1"} 0
+alertmanager_inhibit_rule_inhibited_targets{rule="datacenter-down"} 1
+# HELP alertmanager_inhibit_rule_source_alerts Number of alerts in the source cache of an inhibit rule.
+# TYPE alertmanager_inhibit_rule_source_alerts gauge
+alertmanager_inhibit_rule_source_alerts{rule="1"} 0
+alertmanager_inhibit_rule_source_alerts{rule="datacenter-down"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"alertmanager_inhibit_rule_inhibited_targets",
+		"alertmanager_inhibit_rule_source_alerts",
+	); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(m.evaluationDuration); n != 2 {
+		t.Errorf("expected the evaluation duration of 2 rules, got %d", n)
+	}
+
+	// Once the alert resolves on its own, it is pruned from the targets of
+	// the rule, and it isn't accounted to the rule anymore when it's muted.
+	targetAlert.EndsAt = now.Add(-time.Second)
+	ih.pruneTargets(now)
+	if fps := ih.rules[0].Targets(); len(fps) != 0 {
+		t.Errorf("unexpected targets of the first rule: %v", fps)
+	}
+	if !ih.Mutes(target) {
+		t.Fatalf("expected resolved %s to be muted", target)
+	}
+	if fps := ih.rules[0].Targets(); len(fps) != 0 {
+		t.Errorf("unexpected targets of the first rule: %v", fps)
+	}
+
+	// Alerts which were garbage collected are pruned too.
+	targetAlert.EndsAt = now.Add(time.Hour)
+	if !ih.Mutes(target) {
+		t.Fatalf("expected %s to be muted", target)
+	}
+	if fps := ih.rules[0].Targets(); len(fps) != 1 {
+		t.Errorf("unexpected targets of the first rule: %v", fps)
+	}
+	ap.alerts = nil
+	ih.pruneTargets(now)
+	if fps := ih.rules[0].Targets(); len(fps) != 0 {
+		t.Errorf("unexpected targets of the first rule: %v", fps)
+	}
+
+	// A new inhibitor replaces the rules of the metrics.
+	NewInhibitor(nil, rules[1:], types.NewMarker(prometheus.NewRegistry()), nopLogger, m)
+	if n := testutil.CollectAndCount(m, "alertmanager_inhibit_rule_source_alerts"); n != 1 {
+		t.Errorf("expected the source alerts of 1 rule, got %d", n)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"io"
 	"math/rand"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -43,14 +44,39 @@ var ErrNotFound = errors.New("not found")
 // ErrInvalidState is returned if the state isn't valid.
 var ErrInvalidState = errors.New("invalid state")
 
-// query currently allows filtering by and/or receiver group key.
+// query allows filtering log entries by receiver, group key and time range.
 // It is configured via QueryParameter functions.
-//
-// TODO(fabxc): Future versions could allow querying a certain receiver
-// group or a given time interval.
 type query struct {
-	recv     *pb.Receiver
-	groupKey string
+	recv         *pb.Receiver
+	groupKey     string
+	receiverName string
+	integration  string
+	since        time.Time
+	until        time.Time
+	history      bool
+}
+
+// matches returns whether the entry matches all parameters of the query.
+func (q *query) matches(e *pb.Entry) bool {
+	if q.recv != nil && receiverKey(q.recv) != receiverKey(e.Receiver) {
+		return false
+	}
+	if q.groupKey != "" && q.groupKey != string(e.GroupKey) {
+		return false
+	}
+	if q.receiverName != "" && q.receiverName != e.Receiver.GroupName {
+		return false
+	}
+	if q.integration != "" && q.integration != e.Receiver.Integration {
+		return false
+	}
+	if !q.since.IsZero() && e.Timestamp.Before(q.since) {
+		return false
+	}
+	if !q.until.IsZero() && e.Timestamp.After(q.until) {
+		return false
+	}
+	return true
 }
 
 // QueryParam is a function that modifies a query to incorporate
@@ -74,6 +100,50 @@ func QGroupKey(gk string) QueryParam {
 	}
 }
 
+// QReceiverName restricts a query to the integrations of the receiver with
+// the given name.
+func QReceiverName(name string) QueryParam {
+	return func(q *query) error {
+		q.receiverName = name
+		return nil
+	}
+}
+
+// QIntegration restricts a query to the integrations of the given type, e.g.
+// "email" or "webhook".
+func QIntegration(integration string) QueryParam {
+	return func(q *query) error {
+		q.integration = integration
+		return nil
+	}
+}
+
+// QTimeRange restricts a query to the entries logged between since and until,
+// inclusively. A zero time leaves the corresponding side of the range open.
+func QTimeRange(since, until time.Time) QueryParam {
+	return func(q *query) error {
+		if !since.IsZero() && !until.IsZero() && until.Before(since) {
+			return errors.New("end of time range is before its start")
+		}
+		q.since, q.until = since, until
+		return nil
+	}
+}
+
+// QHistory includes the entries superseded by a newer entry for the same
+// receiver and group key in the query results. Without it, only the most
+// recent entry of each receiver and group key is returned.
+func QHistory() QueryParam {
+	return func(q *query) error {
+		q.history = true
+		return nil
+	}
+}
+
+// maxHistory is the maximum number of superseded entries kept for each
+// receiver and group key.
+const maxHistory = 100
+
 // Log holds the notification log state for alerts that have been notified.
 type Log struct {
 	clock clock.Clock
@@ -82,10 +152,14 @@ type Log struct {
 	metrics   *metrics
 	retention time.Duration
 
-	// For now we only store the most recently added log entry.
-	// The key is a serialized concatenation of group key and receiver.
-	mtx       sync.RWMutex
-	st        state
+	// The state holds the most recently added log entry, which is
+	// gossiped. The key is a serialized concatenation of group key and
+	// receiver.
+	mtx sync.RWMutex
+	st  state
+	// history holds the entries superseded in the state, most recent first,
+	// by the same key. It is only kept locally and in the snapshots.
+	history   map[string][]*pb.MeshEntry
 	broadcast func([]byte)
 }
 
@@ -201,7 +275,19 @@ func (s state) MarshalBinary() ([]byte, error) {
 }
 
 func decodeState(r io.Reader) (state, error) {
+	entries, err := decodeEntries(r)
+	if err != nil {
+		return nil, err
+	}
 	st := state{}
+	for _, e := range entries {
+		st[stateKey(string(e.Entry.GroupKey), e.Entry.Receiver)] = e
+	}
+	return st, nil
+}
+
+func decodeEntries(r io.Reader) ([]*pb.MeshEntry, error) {
+	var entries []*pb.MeshEntry
 	for {
 		var e pb.MeshEntry
 		_, err := pbutil.ReadDelimited(r, &e)
@@ -209,7 +295,7 @@ func decodeState(r io.Reader) (state, error) {
 			if e.Entry == nil || e.Entry.Receiver == nil {
 				return nil, ErrInvalidState
 			}
-			st[stateKey(string(e.Entry.GroupKey), e.Entry.Receiver)] = &e
+			entries = append(entries, &e)
 			continue
 		}
 		if errors.Is(err, io.EOF) {
@@ -217,7 +303,7 @@ func decodeState(r io.Reader) (state, error) {
 		}
 		return nil, err
 	}
-	return st, nil
+	return entries, nil
 }
 
 func marshalMeshEntry(e *pb.MeshEntry) ([]byte, error) {
@@ -259,6 +345,7 @@ func New(o Options) (*Log, error) {
 		retention: o.Retention,
 		logger:    log.NewNopLogger(),
 		st:        state{},
+		history:   map[string][]*pb.MeshEntry{},
 		broadcast: func([]byte) {},
 		metrics:   newMetrics(o.Metrics),
 	}
@@ -409,12 +496,36 @@ func (l *Log) Log(r *pb.Receiver, gkey string, firingAlerts, resolvedAlerts []ui
 	if err != nil {
 		return err
 	}
-	l.st.merge(e, l.now())
+	l.merge(e, l.now())
 	l.broadcast(b)
 
 	return nil
 }
 
+// merge merges the entry into the state, moving the entry it supersedes to
+// the history. It must be called with the lock held.
+func (l *Log) merge(e *pb.MeshEntry, now time.Time) bool {
+	k := stateKey(string(e.Entry.GroupKey), e.Entry.Receiver)
+	prev, ok := l.st[k]
+	if !l.st.merge(e, now) {
+		return false
+	}
+	if ok {
+		l.addHistory(k, prev)
+	}
+	return true
+}
+
+// addHistory adds the superseded entry to the history of the key. It must be
+// called with the lock held.
+func (l *Log) addHistory(k string, e *pb.MeshEntry) {
+	h := append([]*pb.MeshEntry{e}, l.history[k]...)
+	if len(h) > maxHistory {
+		h = h[:maxHistory]
+	}
+	l.history[k] = h
+}
+
 // GC implements the Log interface.
 func (l *Log) GC() (int, error) {
 	start := time.Now()
@@ -435,11 +546,27 @@ func (l *Log) GC() (int, error) {
 			n++
 		}
 	}
+	for k, h := range l.history {
+		kept := h[:0]
+		for _, le := range h {
+			if le.ExpiresAt.After(now) {
+				kept = append(kept, le)
+				continue
+			}
+			n++
+		}
+		if len(kept) == 0 {
+			delete(l.history, k)
+			continue
+		}
+		l.history[k] = kept
+	}
 
 	return n, nil
 }
 
-// Query implements the Log interface.
+// Query implements the Log interface. It returns the entries matching all the
+// given parameters, most recent first, or ErrNotFound if there is none.
 func (l *Log) Query(params ...QueryParam) ([]*pb.Entry, error) {
 	start := time.Now()
 	l.metrics.queriesTotal.Inc()
@@ -451,21 +578,41 @@ func (l *Log) Query(params ...QueryParam) ([]*pb.Entry, error) {
 				return nil, err
 			}
 		}
-		// TODO(fabxc): For now our only query mode is the most recent entry for a
-		// receiver/group_key combination.
-		if q.recv == nil || q.groupKey == "" {
-			// TODO(fabxc): allow more complex queries in the future.
-			// How to enable pagination?
-			return nil, errors.New("no query parameters specified")
-		}
 
 		l.mtx.RLock()
 		defer l.mtx.RUnlock()
 
-		if le, ok := l.st[stateKey(q.groupKey, q.recv)]; ok {
-			return []*pb.Entry{le.Entry}, nil
+		// The most recent entry for a receiver and group key can be looked
+		// up directly, which is what the notification pipeline does.
+		if q.recv != nil && q.groupKey != "" && !q.history {
+			if le, ok := l.st[stateKey(q.groupKey, q.recv)]; ok && q.matches(le.Entry) {
+				return []*pb.Entry{le.Entry}, nil
+			}
+			return nil, ErrNotFound
+		}
+
+		var entries []*pb.Entry
+		for _, le := range l.st {
+			if q.matches(le.Entry) {
+				entries = append(entries, le.Entry)
+			}
+		}
+		if q.history {
+			for _, h := range l.history {
+				for _, le := range h {
+					if q.matches(le.Entry) {
+						entries = append(entries, le.Entry)
+					}
+				}
+			}
+		}
+		if len(entries) == 0 {
+			return nil, ErrNotFound
 		}
-		return nil, ErrNotFound
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Timestamp.After(entries[j].Timestamp)
+		})
+		return entries, nil
 	}()
 	if err != nil {
 		l.metrics.queryErrorsTotal.Inc()
@@ -474,16 +621,31 @@ func (l *Log) Query(params ...QueryParam) ([]*pb.Entry, error) {
 	return entries, err
 }
 
-// loadSnapshot loads a snapshot generated by Snapshot() into the state.
+// loadSnapshot loads a snapshot generated by Snapshot() into the state and
+// the history.
 func (l *Log) loadSnapshot(r io.Reader) error {
-	st, err := decodeState(r)
+	entries, err := decodeEntries(r)
 	if err != nil {
 		return err
 	}
+	// Merging the entries from the oldest to the most recent rebuilds the
+	// history.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Entry.Timestamp.Before(entries[j].Entry.Timestamp)
+	})
 
 	l.mtx.Lock()
-	l.st = st
-	l.mtx.Unlock()
+	defer l.mtx.Unlock()
+
+	l.st = state{}
+	l.history = map[string][]*pb.MeshEntry{}
+	for _, e := range entries {
+		k := stateKey(string(e.Entry.GroupKey), e.Entry.Receiver)
+		if prev, ok := l.st[k]; ok {
+			l.addHistory(k, prev)
+		}
+		l.st[k] = e
+	}
 
 	return nil
 }
@@ -500,8 +662,16 @@ func (l *Log) Snapshot(w io.Writer) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	buf := bytes.NewBuffer(b)
+	for _, h := range l.history {
+		for _, e := range h {
+			if _, err := pbutil.WriteDelimited(buf, e); err != nil {
+				return 0, err
+			}
+		}
+	}
 
-	return io.Copy(w, bytes.NewReader(b))
+	return io.Copy(w, buf)
 }
 
 // MarshalBinary serializes all contents of the notification log.
@@ -523,7 +693,7 @@ func (l *Log) Merge(b []byte) error {
 	now := l.now()
 
 	for _, e := range st {
-		if merged := l.st.merge(e, now); merged && !cluster.OversizedMessage(b) {
+		if merged := l.merge(e, now); merged && !cluster.OversizedMessage(b) {
 			// If this is the first we've seen the message and it's
 			// not oversized, gossip it to other nodes. We don't
 			// propagate oversized messages because they're sent to
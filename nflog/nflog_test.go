@@ -339,14 +339,6 @@ func TestQuery(t *testing.T) {
 
 	recv := new(pb.Receiver)
 
-	// no key param
-	_, err = nl.Query(QGroupKey("key"))
-	require.EqualError(t, err, "no query parameters specified")
-
-	// no recv param
-	_, err = nl.Query(QReceiver(recv))
-	require.EqualError(t, err, "no query parameters specified")
-
 	// no entry
 	_, err = nl.Query(QGroupKey("nonexistentkey"), QReceiver(recv))
 	require.EqualError(t, err, "not found")
@@ -365,6 +357,125 @@ func TestQuery(t *testing.T) {
 	require.EqualValues(t, resolvedAlerts, entry.ResolvedAlerts)
 }
 
+func TestQueryFilters(t *testing.T) {
+	mockClock := clock.NewMock()
+	nl, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	nl.clock = mockClock
+
+	email := &pb.Receiver{GroupName: "team-a", Integration: "email"}
+	webhook := &pb.Receiver{GroupName: "team-a", Integration: "webhook"}
+	pager := &pb.Receiver{GroupName: "team-b", Integration: "pagerduty"}
+
+	start := mockClock.Now()
+	require.NoError(t, nl.Log(email, "g1", []uint64{1}, nil, 0))
+	mockClock.Add(time.Minute)
+	require.NoError(t, nl.Log(webhook, "g1", []uint64{1}, nil, 0))
+	mockClock.Add(time.Minute)
+	require.NoError(t, nl.Log(pager, "g2", nil, []uint64{2}, 0))
+
+	for _, tc := range []struct {
+		name     string
+		params   []QueryParam
+		expected []*pb.Receiver
+	}{
+		{
+			name:     "no parameters",
+			expected: []*pb.Receiver{pager, webhook, email},
+		},
+		{
+			name:     "group key",
+			params:   []QueryParam{QGroupKey("g1")},
+			expected: []*pb.Receiver{webhook, email},
+		},
+		{
+			name:     "receiver name",
+			params:   []QueryParam{QReceiverName("team-b")},
+			expected: []*pb.Receiver{pager},
+		},
+		{
+			name:     "integration",
+			params:   []QueryParam{QReceiverName("team-a"), QIntegration("email")},
+			expected: []*pb.Receiver{email},
+		},
+		{
+			name:     "time range",
+			params:   []QueryParam{QTimeRange(start.Add(30*time.Second), start.Add(90*time.Second))},
+			expected: []*pb.Receiver{webhook},
+		},
+		{
+			name:     "open time range",
+			params:   []QueryParam{QTimeRange(start.Add(time.Minute), time.Time{})},
+			expected: []*pb.Receiver{pager, webhook},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := nl.Query(tc.params...)
+			require.NoError(t, err)
+			receivers := make([]*pb.Receiver, 0, len(entries))
+			for _, e := range entries {
+				receivers = append(receivers, e.Receiver)
+			}
+			require.Equal(t, tc.expected, receivers)
+		})
+	}
+
+	_, err = nl.Query(QReceiverName("team-c"))
+	require.Equal(t, ErrNotFound, err)
+
+	_, err = nl.Query(QTimeRange(start, start.Add(-time.Second)))
+	require.EqualError(t, err, "end of time range is before its start")
+}
+
+func TestQueryHistory(t *testing.T) {
+	mockClock := clock.NewMock()
+	nl, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	nl.clock = mockClock
+
+	recv := &pb.Receiver{GroupName: "team-a", Integration: "email"}
+	for i := 0; i < maxHistory+2; i++ {
+		require.NoError(t, nl.Log(recv, "g1", []uint64{uint64(i)}, nil, 0))
+		mockClock.Add(time.Minute)
+	}
+
+	// The notification pipeline only gets the most recent entry.
+	entries, err := nl.Query(QGroupKey("g1"), QReceiver(recv))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, []uint64{maxHistory + 1}, entries[0].FiringAlerts)
+
+	// The history is bounded and returned most recent first.
+	entries, err = nl.Query(QGroupKey("g1"), QReceiver(recv), QHistory())
+	require.NoError(t, err)
+	require.Len(t, entries, maxHistory+1)
+	for i, e := range entries {
+		require.Equal(t, []uint64{uint64(maxHistory + 1 - i)}, e.FiringAlerts)
+	}
+
+	// The history is kept in the snapshot.
+	var buf bytes.Buffer
+	_, err = nl.Snapshot(&buf)
+	require.NoError(t, err)
+	restored, err := New(Options{SnapshotReader: &buf, Retention: time.Hour})
+	require.NoError(t, err)
+	restoredEntries, err := restored.Query(QHistory())
+	require.NoError(t, err)
+	require.Len(t, restoredEntries, len(entries))
+	for i, e := range restoredEntries {
+		require.Equal(t, entries[i].FiringAlerts, e.FiringAlerts)
+	}
+
+	// The entries of the history expire like the others, only the 10 most
+	// recent ones are left.
+	mockClock.Add(time.Hour - 11*time.Minute)
+	_, err = nl.GC()
+	require.NoError(t, err)
+	entries, err = nl.Query(QHistory())
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+}
+
 func TestStateDecodingError(t *testing.T) {
 	// Check whether decoding copes with erroneous data.
 	s := state{"": &pb.MeshEntry{}}
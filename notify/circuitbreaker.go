@@ -0,0 +1,313 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrCircuitOpen is returned when a notification isn't attempted because the
+// circuit breaker of the integration is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all notifications through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails all notifications without attempting them.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe notification through to find out
+	// whether the integration recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		panic(fmt.Sprintf("unknown CircuitState: %d", s))
+	}
+}
+
+// CircuitBreaker tracks the consecutive failures of a single integration. It
+// opens once the failure threshold is reached and fails notifications fast
+// until the open duration has elapsed. It then lets a single probe through,
+// which closes the circuit on success and opens it again on failure.
+type CircuitBreaker struct {
+	receiver    string
+	integration string
+	idx         int
+
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+	metrics      *circuitBreakerMetrics
+
+	mtx      sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// CircuitBreakerStatus is the current state of a circuit breaker.
+type CircuitBreakerStatus struct {
+	Integration         string
+	Index               int
+	State               CircuitState
+	ConsecutiveFailures int
+	// OpenedAt is the time the circuit was last opened, it is zero if the
+	// circuit is closed.
+	OpenedAt time.Time
+}
+
+// Status returns the current state of the circuit breaker.
+func (cb *CircuitBreaker) Status() CircuitBreakerStatus {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	s := CircuitBreakerStatus{
+		Integration:         cb.integration,
+		Index:               cb.idx,
+		State:               cb.state,
+		ConsecutiveFailures: cb.failures,
+	}
+	if cb.state != CircuitClosed {
+		s.OpenedAt = cb.openedAt
+	}
+	return s
+}
+
+// Allow returns whether a notification may be attempted. If it returns true,
+// the outcome must be reported with Done.
+func (cb *CircuitBreaker) Allow() bool {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	switch cb.state {
+	case CircuitOpen:
+		if cb.now().Before(cb.openedAt.Add(cb.openDuration)) {
+			break
+		}
+		cb.setState(CircuitHalfOpen)
+		fallthrough
+	case CircuitHalfOpen:
+		if cb.probing {
+			break
+		}
+		cb.probing = true
+		return true
+	default:
+		return true
+	}
+	cb.metrics.rejectedTotal.WithLabelValues(cb.labelValues()...).Inc()
+	return false
+}
+
+// Done reports the outcome of a notification attempt allowed by Allow. Failed
+// attempts that shouldn't count against the integration, e.g. because the
+// error isn't retryable or the context was canceled, are reported with
+// counted set to false.
+func (cb *CircuitBreaker) Done(err error, counted bool) {
+	cb.mtx.Lock()
+	defer cb.mtx.Unlock()
+
+	cb.probing = false
+	if err == nil {
+		cb.failures = 0
+		cb.setState(CircuitClosed)
+		return
+	}
+	if !counted {
+		return
+	}
+
+	cb.failures++
+	if cb.state == CircuitHalfOpen || cb.failures >= cb.threshold {
+		cb.openedAt = cb.now()
+		cb.setState(CircuitOpen)
+	}
+}
+
+// setState must be called with the lock held.
+func (cb *CircuitBreaker) setState(s CircuitState) {
+	if s == CircuitOpen && cb.state != CircuitOpen {
+		cb.metrics.openedTotal.WithLabelValues(cb.labelValues()...).Inc()
+	}
+	cb.state = s
+	cb.metrics.state.WithLabelValues(cb.labelValues()...).Set(float64(s))
+}
+
+func (cb *CircuitBreaker) labelValues() []string {
+	return []string{cb.receiver, cb.integration, strconv.Itoa(cb.idx)}
+}
+
+type circuitBreakerMetrics struct {
+	state         *prometheus.GaugeVec
+	openedTotal   *prometheus.CounterVec
+	rejectedTotal *prometheus.CounterVec
+}
+
+func newCircuitBreakerMetrics(r prometheus.Registerer) *circuitBreakerMetrics {
+	labels := []string{"receiver_name", "integration", "index"}
+	m := &circuitBreakerMetrics{
+		state: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "alertmanager_notifications_circuit_breaker_state",
+			Help: "State of the circuit breaker of an integration (0 = closed, 1 = open, 2 = half-open).",
+		}, labels),
+		openedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_notifications_circuit_breaker_opened_total",
+			Help: "Number of times the circuit breaker of an integration opened.",
+		}, labels),
+		rejectedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_notifications_circuit_breaker_rejected_total",
+			Help: "Number of notification attempts failed fast by the circuit breaker of an integration.",
+		}, labels),
+	}
+	if r != nil {
+		r.MustRegister(m.state, m.openedTotal, m.rejectedTotal)
+	}
+	return m
+}
+
+// CircuitBreakers holds the circuit breakers of all integrations. The state of
+// the breakers is kept across configuration reloads for the integrations that
+// still exist.
+type CircuitBreakers struct {
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+	metrics      *circuitBreakerMetrics
+
+	mtx      sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewCircuitBreakers returns circuit breakers which open after threshold
+// consecutive failures and stay open for openDuration. A threshold of 0
+// disables the circuit breakers.
+func NewCircuitBreakers(threshold int, openDuration time.Duration, r prometheus.Registerer) (*CircuitBreakers, error) {
+	if threshold < 0 {
+		return nil, fmt.Errorf("invalid failure threshold %d", threshold)
+	}
+	if threshold > 0 && openDuration <= 0 {
+		return nil, fmt.Errorf("invalid open duration %s", openDuration)
+	}
+	return &CircuitBreakers{
+		threshold:    threshold,
+		openDuration: openDuration,
+		now:          time.Now,
+		metrics:      newCircuitBreakerMetrics(r),
+		breakers:     map[string]*CircuitBreaker{},
+	}, nil
+}
+
+func circuitBreakerKey(receiver, integration string, idx int) string {
+	return fmt.Sprintf("%s/%s/%d", receiver, integration, idx)
+}
+
+// Get returns the circuit breaker of the integration, creating it if needed.
+// It returns nil if the circuit breakers are disabled.
+func (c *CircuitBreakers) Get(receiver string, i *Integration) *CircuitBreaker {
+	if c == nil || c.threshold == 0 {
+		return nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := circuitBreakerKey(receiver, i.Name(), i.Index())
+	if cb, ok := c.breakers[key]; ok {
+		return cb
+	}
+	cb := &CircuitBreaker{
+		receiver:     receiver,
+		integration:  i.Name(),
+		idx:          i.Index(),
+		threshold:    c.threshold,
+		openDuration: c.openDuration,
+		now:          c.now,
+		metrics:      c.metrics,
+	}
+	cb.metrics.state.WithLabelValues(cb.labelValues()...).Set(float64(CircuitClosed))
+	c.breakers[key] = cb
+	return cb
+}
+
+// Sync removes the circuit breakers of the integrations which aren't part of
+// the given receivers anymore.
+func (c *CircuitBreakers) Sync(receivers map[string][]Integration) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	keep := map[string]struct{}{}
+	for name, integrations := range receivers {
+		for _, i := range integrations {
+			keep[circuitBreakerKey(name, i.Name(), i.Index())] = struct{}{}
+		}
+	}
+	for key, cb := range c.breakers {
+		if _, ok := keep[key]; ok {
+			continue
+		}
+		lv := cb.labelValues()
+		c.metrics.state.DeleteLabelValues(lv...)
+		c.metrics.openedTotal.DeleteLabelValues(lv...)
+		c.metrics.rejectedTotal.DeleteLabelValues(lv...)
+		delete(c.breakers, key)
+	}
+}
+
+// Status returns the state of the circuit breakers of the receiver's
+// integrations, ordered by integration name and index.
+func (c *CircuitBreakers) Status(receiver string) []CircuitBreakerStatus {
+	if c == nil {
+		return nil
+	}
+
+	c.mtx.Lock()
+	var res []CircuitBreakerStatus
+	for _, cb := range c.breakers {
+		if cb.receiver == receiver {
+			res = append(res, cb.Status())
+		}
+	}
+	c.mtx.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Integration != res[j].Integration {
+			return res[i].Integration < res[j].Integration
+		}
+		return res[i].Index < res[j].Index
+	})
+	return res
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/featurecontrol"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	c, err := NewCircuitBreakers(2, time.Minute, prometheus.NewRegistry())
+	require.NoError(t, err)
+	c.now = func() time.Time { return now }
+
+	i := NewIntegration(nil, nil, "webhook", 0, "team-X")
+	cb := c.Get("team-X", &i)
+	require.Same(t, cb, c.Get("team-X", &i))
+
+	errFail := errors.New("unavailable")
+
+	// Failures caused by the context being done aren't counted.
+	require.True(t, cb.Allow())
+	cb.Done(errFail, false)
+	require.Equal(t, 0, cb.Status().ConsecutiveFailures)
+
+	require.True(t, cb.Allow())
+	cb.Done(errFail, true)
+	require.Equal(t, CircuitClosed, cb.Status().State)
+	require.True(t, cb.Allow())
+	cb.Done(errFail, true)
+	require.Equal(t, CircuitOpen, cb.Status().State)
+	require.Equal(t, now, cb.Status().OpenedAt)
+
+	// The circuit fails fast while open.
+	require.False(t, cb.Allow())
+	require.Equal(t, 1.0, testutil.ToFloat64(c.metrics.rejectedTotal.WithLabelValues("team-X", "webhook", "0")))
+
+	// A single probe is let through once the open duration elapsed, a failed
+	// probe opens the circuit again.
+	now = now.Add(time.Minute)
+	require.True(t, cb.Allow())
+	require.Equal(t, CircuitHalfOpen, cb.Status().State)
+	require.False(t, cb.Allow())
+	cb.Done(errFail, true)
+	require.Equal(t, CircuitOpen, cb.Status().State)
+	require.False(t, cb.Allow())
+
+	// A successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	require.True(t, cb.Allow())
+	cb.Done(nil, true)
+	require.Equal(t, CircuitBreakerStatus{Integration: "webhook", State: CircuitClosed}, cb.Status())
+	require.True(t, cb.Allow())
+	require.Equal(t, 2.0, testutil.ToFloat64(c.metrics.openedTotal.WithLabelValues("team-X", "webhook", "0")))
+
+	require.Len(t, c.Status("team-X"), 1)
+	c.Sync(map[string][]Integration{"team-Y": {i}})
+	require.Empty(t, c.Status("team-X"))
+}
+
+func TestCircuitBreakersDisabled(t *testing.T) {
+	c, err := NewCircuitBreakers(0, 0, nil)
+	require.NoError(t, err)
+	i := NewIntegration(nil, nil, "webhook", 0, "team-X")
+	require.Nil(t, c.Get("team-X", &i))
+
+	var nilBreakers *CircuitBreakers
+	require.Nil(t, nilBreakers.Get("team-X", &i))
+	require.Nil(t, nilBreakers.Status("team-X"))
+
+	_, err = NewCircuitBreakers(-1, time.Minute, nil)
+	require.Error(t, err)
+	_, err = NewCircuitBreakers(1, 0, nil)
+	require.Error(t, err)
+}
+
+func TestRetryStageCircuitBreaker(t *testing.T) {
+	c, err := NewCircuitBreakers(1, time.Hour, nil)
+	require.NoError(t, err)
+
+	var attempts int
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			attempts++
+			return true, NewErrorWithReason(ServerErrorReason, errors.New("unavailable"))
+		}),
+		rs:   sendResolved(false),
+		name: "webhook",
+	}
+	r := NewRetryStage(i, "team-X", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, c.Get("team-X", &i))
+
+	ctx := WithFiringAlerts(context.Background(), []uint64{0})
+	alert := newDeadLetterTestAlert("a1")
+
+	// The retry loop stops as soon as the circuit opens.
+	_, _, err = r.Exec(ctx, log.NewNopLogger(), alert)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.ErrorContains(t, err, "unavailable")
+	require.Equal(t, CircuitOpenReason.String(), failureReason(err))
+	require.Equal(t, 1, attempts)
+
+	// Further notifications fail fast.
+	_, _, err = r.Exec(ctx, log.NewNopLogger(), alert)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryStageCircuitBreakerNonRetryable(t *testing.T) {
+	c, err := NewCircuitBreakers(1, time.Hour, nil)
+	require.NoError(t, err)
+
+	var attempts int
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			attempts++
+			return false, NewErrorWithReason(ClientErrorReason, errors.New("bad request"))
+		}),
+		rs:   sendResolved(false),
+		name: "webhook",
+	}
+	cb := c.Get("team-X", &i)
+	r := NewRetryStage(i, "team-X", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, cb)
+
+	ctx := WithFiringAlerts(context.Background(), []uint64{0})
+	alert := newDeadLetterTestAlert("a1")
+
+	// Unrecoverable errors don't count against the integration.
+	for n := 1; n <= 3; n++ {
+		_, _, err = r.Exec(ctx, log.NewNopLogger(), alert)
+		require.ErrorContains(t, err, "bad request")
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+		require.Equal(t, n, attempts)
+	}
+	require.Equal(t, CircuitClosed, cb.Status().State)
+	require.Zero(t, cb.Status().ConsecutiveFailures)
+}
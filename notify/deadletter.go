@@ -0,0 +1,341 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	uuid "github.com/gofrs/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+// ErrDeadLetterNotFound is returned if a dead letter doesn't exist.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter is a notification that RetryStage gave up on.
+type DeadLetter struct {
+	ID          string         `json:"id"`
+	Timestamp   time.Time      `json:"timestamp"`
+	Receiver    string         `json:"receiver"`
+	Integration string         `json:"integration"`
+	Index       int            `json:"index"`
+	GroupKey    string         `json:"groupKey"`
+	GroupLabels model.LabelSet `json:"groupLabels"`
+	Alerts      []*types.Alert `json:"alerts"`
+	// Reason is the failure reason of the last attempt.
+	Reason string `json:"reason"`
+	// Error is the error of the last attempt.
+	Error string `json:"error"`
+	// Redrives is the number of failed attempts to re-drive the notification.
+	Redrives int `json:"redrives"`
+}
+
+// Fingerprints returns the fingerprints of the alerts of the notification.
+func (d *DeadLetter) Fingerprints() []model.Fingerprint {
+	fps := make([]model.Fingerprint, 0, len(d.Alerts))
+	for _, a := range d.Alerts {
+		fps = append(fps, a.Fingerprint())
+	}
+	return fps
+}
+
+// DeadLetterStore is a bounded store of failed notifications. It is persisted
+// to disk periodically by Maintenance, the oldest entries are dropped once it
+// is full.
+type DeadLetterStore struct {
+	filename   string
+	maxEntries int
+	logger     log.Logger
+	metrics    *deadLetterMetrics
+
+	// fileMtx serializes the writes of the file.
+	fileMtx sync.Mutex
+
+	mtx     sync.RWMutex
+	entries []*DeadLetter
+	// dirty is set when the entries changed since they were last written to
+	// disk.
+	dirty bool
+	// receivers holds the integrations of the current configuration by
+	// receiver name, they are used to re-drive the notifications.
+	receivers map[string][]Integration
+}
+
+type deadLetterMetrics struct {
+	entries       prometheus.Gauge
+	droppedTotal  prometheus.Counter
+	redrivesTotal *prometheus.CounterVec
+}
+
+func newDeadLetterMetrics(r prometheus.Registerer) *deadLetterMetrics {
+	m := &deadLetterMetrics{
+		entries: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alertmanager_notifications_dead_letters",
+			Help: "Number of failed notifications in the dead-letter store.",
+		}),
+		droppedTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_notifications_dead_letters_dropped_total",
+			Help: "Number of failed notifications dropped from the dead-letter store because it was full.",
+		}),
+		redrivesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_notifications_dead_letter_redrives_total",
+			Help: "Number of attempts to re-drive failed notifications from the dead-letter store.",
+		}, []string{"result"}),
+	}
+	m.redrivesTotal.WithLabelValues("success")
+	m.redrivesTotal.WithLabelValues("failure")
+	if r != nil {
+		r.MustRegister(m.entries, m.droppedTotal, m.redrivesTotal)
+	}
+	return m
+}
+
+// NewDeadLetterStore returns a dead-letter store holding at most maxEntries
+// notifications. The entries are loaded from and flushed to filename, if
+// set.
+func NewDeadLetterStore(filename string, maxEntries int, l log.Logger, r prometheus.Registerer) (*DeadLetterStore, error) {
+	if maxEntries <= 0 {
+		return nil, fmt.Errorf("invalid maximum number of entries %d", maxEntries)
+	}
+	if l == nil {
+		l = log.NewNopLogger()
+	}
+	s := &DeadLetterStore{
+		filename:   filename,
+		maxEntries: maxEntries,
+		logger:     l,
+		metrics:    newDeadLetterMetrics(r),
+	}
+
+	if filename != "" {
+		b, err := os.ReadFile(filename)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &s.entries); err != nil {
+				return nil, fmt.Errorf("decode dead letters: %w", err)
+			}
+		}
+	}
+	s.truncate()
+	s.metrics.entries.Set(float64(len(s.entries)))
+
+	return s, nil
+}
+
+// SetReceivers sets the integrations used to re-drive notifications.
+func (s *DeadLetterStore) SetReceivers(receivers map[string][]Integration) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.receivers = receivers
+}
+
+// truncate drops the oldest entries exceeding the capacity of the store. It
+// must be called with the lock held.
+func (s *DeadLetterStore) truncate() {
+	if n := len(s.entries) - s.maxEntries; n > 0 {
+		s.entries = append([]*DeadLetter(nil), s.entries[n:]...)
+		s.metrics.droppedTotal.Add(float64(n))
+	}
+}
+
+// changed records that the entries changed and must be written to disk. It
+// must be called with the lock held.
+func (s *DeadLetterStore) changed() {
+	s.metrics.entries.Set(float64(len(s.entries)))
+	s.dirty = true
+}
+
+// Flush atomically writes the entries to disk if they changed since the last
+// flush.
+func (s *DeadLetterStore) Flush() error {
+	if s.filename == "" {
+		return nil
+	}
+
+	s.fileMtx.Lock()
+	defer s.fileMtx.Unlock()
+
+	s.mtx.Lock()
+	if !s.dirty {
+		s.mtx.Unlock()
+		return nil
+	}
+	b, err := json.Marshal(s.entries)
+	s.dirty = false
+	s.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = func() error {
+		tmpFilename := fmt.Sprintf("%s.%x", s.filename, uint64(rand.Int63()))
+		if err := os.WriteFile(tmpFilename, b, 0o666); err != nil {
+			return err
+		}
+		if err := os.Rename(tmpFilename, s.filename); err != nil {
+			os.Remove(tmpFilename)
+			return err
+		}
+		return nil
+	}()
+	if err != nil {
+		// Try again with the next flush.
+		s.mtx.Lock()
+		s.dirty = true
+		s.mtx.Unlock()
+	}
+	return err
+}
+
+// Maintenance writes the entries to disk at the given interval if they
+// changed, and a last time when stopc is closed.
+func (s *DeadLetterStore) Maintenance(interval time.Duration, stopc <-chan struct{}) {
+	if interval == 0 || stopc == nil {
+		level.Error(s.logger).Log("msg", "interval or stop signal are missing - not running maintenance")
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stopc:
+			if err := s.Flush(); err != nil {
+				level.Error(s.logger).Log("msg", "Writing dead letters on shutdown failed", "err", err)
+			}
+			return
+		case <-t.C:
+			if err := s.Flush(); err != nil {
+				level.Error(s.logger).Log("msg", "Writing dead letters failed", "err", err)
+			}
+		}
+	}
+}
+
+// Add stores a failed notification. The ID and timestamp are set if missing.
+func (s *DeadLetterStore) Add(d *DeadLetter) error {
+	if d.ID == "" {
+		uid, err := uuid.NewV4()
+		if err != nil {
+			return fmt.Errorf("generate uuid: %w", err)
+		}
+		d.ID = uid.String()
+	}
+	if d.Timestamp.IsZero() {
+		d.Timestamp = time.Now()
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.entries = append(s.entries, d)
+	s.truncate()
+	s.changed()
+	return nil
+}
+
+// List returns the stored notifications, oldest first.
+func (s *DeadLetterStore) List() []*DeadLetter {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return append([]*DeadLetter(nil), s.entries...)
+}
+
+func (s *DeadLetterStore) index(id string) int {
+	for i, d := range s.entries {
+		if d.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Redrive sends the notification with the given ID again through its
+// integration. The notification is removed from the store on success,
+// otherwise its error is updated.
+func (s *DeadLetterStore) Redrive(ctx context.Context, id string) error {
+	s.mtx.RLock()
+	i := s.index(id)
+	if i < 0 {
+		s.mtx.RUnlock()
+		return ErrDeadLetterNotFound
+	}
+	d := *s.entries[i]
+	var integration *Integration
+	for _, in := range s.receivers[d.Receiver] {
+		if in.Name() == d.Integration && in.Index() == d.Index {
+			integration = &in
+			break
+		}
+	}
+	s.mtx.RUnlock()
+
+	if integration == nil {
+		return fmt.Errorf("integration %s[%d] of receiver %q doesn't exist anymore", d.Integration, d.Index, d.Receiver)
+	}
+
+	ctx = WithReceiverName(ctx, d.Receiver)
+	ctx = WithGroupKey(ctx, d.GroupKey)
+	ctx = WithGroupLabels(ctx, d.GroupLabels)
+	ctx = WithNow(ctx, time.Now())
+
+	_, err := integration.Notify(ctx, d.Alerts...)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err != nil {
+		s.metrics.redrivesTotal.WithLabelValues("failure").Inc()
+		level.Warn(s.logger).Log("msg", "Re-driving failed notification failed", "id", id, "receiver", d.Receiver, "integration", integration.String(), "err", err)
+		if i := s.index(id); i >= 0 {
+			s.entries[i].Redrives++
+			s.entries[i].Reason = failureReason(err)
+			s.entries[i].Error = err.Error()
+			s.changed()
+		}
+		return err
+	}
+
+	s.metrics.redrivesTotal.WithLabelValues("success").Inc()
+	if i := s.index(id); i >= 0 {
+		s.entries = append(s.entries[:i], s.entries[i+1:]...)
+		s.changed()
+	}
+	return nil
+}
+
+// failureReason returns the reason of the notification error.
+func failureReason(err error) string {
+	var e *ErrorWithReason
+	if errors.As(err, &e) {
+		return e.Reason.String()
+	}
+	return DefaultReason.String()
+}
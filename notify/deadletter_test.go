@@ -0,0 +1,243 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/featurecontrol"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newDeadLetterTestAlert(name string) *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": model.LabelValue(name)},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestDeadLetterStore(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "dead_letters")
+
+	s, err := NewDeadLetterStore(filename, 2, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	for _, name := range []string{"a1", "a2", "a3"} {
+		require.NoError(t, s.Add(&DeadLetter{
+			Receiver:    "team-X",
+			Integration: "webhook",
+			GroupKey:    "{}:{}",
+			Alerts:      []*types.Alert{newDeadLetterTestAlert(name)},
+		}))
+	}
+
+	// The oldest entry was dropped.
+	entries := s.List()
+	require.Len(t, entries, 2)
+	require.Equal(t, newDeadLetterTestAlert("a2").Fingerprint(), entries[0].Fingerprints()[0])
+	require.Equal(t, newDeadLetterTestAlert("a3").Fingerprint(), entries[1].Fingerprints()[0])
+	require.NotEmpty(t, entries[0].ID)
+	require.NotEqual(t, entries[0].ID, entries[1].ID)
+
+	// The entries are only written to disk when flushed.
+	_, err = os.Stat(filename)
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, s.Flush())
+
+	// The entries are restored from disk.
+	restored, err := NewDeadLetterStore(filename, 1, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+	require.Len(t, restored.List(), 1)
+	require.Equal(t, entries[1].ID, restored.List()[0].ID)
+
+	_, err = NewDeadLetterStore("", 0, nil, nil)
+	require.Error(t, err)
+}
+
+func TestDeadLetterStoreMaintenance(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "dead_letters")
+	s, err := NewDeadLetterStore(filename, 10, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	stopc := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.Maintenance(time.Hour, stopc)
+		close(done)
+	}()
+
+	require.NoError(t, s.Add(&DeadLetter{Receiver: "team-X", Integration: "webhook"}))
+
+	// The entries are written to disk on shutdown.
+	close(stopc)
+	<-done
+	restored, err := NewDeadLetterStore(filename, 10, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+	require.Len(t, restored.List(), 1)
+}
+
+func TestDeadLetterStoreRedrive(t *testing.T) {
+	s, err := NewDeadLetterStore("", 10, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	fail := true
+	var notified []*types.Alert
+	var receiver string
+	s.SetReceivers(map[string][]Integration{
+		"team-X": {
+			NewIntegration(notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+				if fail {
+					return false, NewErrorWithReason(ClientErrorReason, errors.New("bad request"))
+				}
+				receiver, _ = ReceiverName(ctx)
+				notified = alerts
+				return false, nil
+			}), sendResolved(true), "webhook", 0, "team-X"),
+		},
+	})
+
+	alert := newDeadLetterTestAlert("a1")
+	d := &DeadLetter{Receiver: "team-X", Integration: "webhook", Alerts: []*types.Alert{alert}}
+	require.NoError(t, s.Add(d))
+	require.NoError(t, s.Add(&DeadLetter{Receiver: "team-Y", Integration: "email"}))
+
+	require.Equal(t, ErrDeadLetterNotFound, s.Redrive(context.Background(), "unknown"))
+	require.EqualError(t, s.Redrive(context.Background(), s.List()[1].ID), `integration email[0] of receiver "team-Y" doesn't exist anymore`)
+
+	// A failed attempt updates the entry.
+	require.EqualError(t, s.Redrive(context.Background(), d.ID), "bad request")
+	require.Equal(t, 1, s.List()[0].Redrives)
+	require.Equal(t, ClientErrorReason.String(), s.List()[0].Reason)
+
+	// A successful attempt removes it.
+	fail = false
+	require.NoError(t, s.Redrive(context.Background(), d.ID))
+	require.Equal(t, "team-X", receiver)
+	require.Equal(t, []*types.Alert{alert}, notified)
+	require.Len(t, s.List(), 1)
+}
+
+func TestRetryStageDeadLetter(t *testing.T) {
+	s, err := NewDeadLetterStore("", 10, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			return false, NewErrorWithReason(ServerErrorReason, errors.New("unavailable"))
+		}),
+		rs:   sendResolved(false),
+		name: "webhook",
+		idx:  1,
+	}
+	r := NewRetryStage(i, "team-X", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), s, nil)
+
+	alert := newDeadLetterTestAlert("a1")
+	ctx := WithFiringAlerts(context.Background(), []uint64{0})
+	ctx = WithGroupKey(ctx, "{}:{alertname=\"a1\"}")
+	ctx = WithGroupLabels(ctx, model.LabelSet{"alertname": "a1"})
+
+	_, _, err = r.Exec(ctx, log.NewNopLogger(), alert)
+	require.Error(t, err)
+
+	entries := s.List()
+	require.Len(t, entries, 1)
+	require.Equal(t, "team-X", entries[0].Receiver)
+	require.Equal(t, "webhook", entries[0].Integration)
+	require.Equal(t, 1, entries[0].Index)
+	require.Equal(t, "{}:{alertname=\"a1\"}", entries[0].GroupKey)
+	require.Equal(t, model.LabelSet{"alertname": "a1"}, entries[0].GroupLabels)
+	require.Equal(t, []model.Fingerprint{alert.Fingerprint()}, entries[0].Fingerprints())
+	require.Equal(t, ServerErrorReason.String(), entries[0].Reason)
+	require.Contains(t, entries[0].Error, "unavailable")
+
+	// Notifications canceled by a shutdown or rejected by an open
+	// circuit breaker are retried with the next flush instead.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = r.Exec(canceled, log.NewNopLogger(), alert)
+	require.Error(t, err)
+
+	cbs, err := NewCircuitBreakers(1, time.Hour, nil)
+	require.NoError(t, err)
+	i.notifier = notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+		return true, errors.New("unavailable")
+	})
+	r = NewRetryStage(i, "team-X", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), s, cbs.Get("team-X", &i))
+	_, _, err = r.Exec(ctx, log.NewNopLogger(), alert)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	require.Len(t, s.List(), 1)
+}
+
+func TestRetryStageDeadLetterTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	s, err := NewDeadLetterStore("", 10, nil, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL, nil)
+			if err != nil {
+				return false, err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return true, err
+			}
+			resp.Body.Close()
+			return true, NewErrorWithReason(ServerErrorReason, fmt.Errorf("unexpected status code %d", resp.StatusCode))
+		}),
+		rs:   sendResolved(false),
+		name: "webhook",
+		idx:  0,
+	}
+	r := NewRetryStage(i, "team-X", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), s, nil)
+
+	ctx := WithFiringAlerts(context.Background(), []uint64{0})
+	ctx = WithGroupKey(ctx, "{}:{alertname=\"a1\"}")
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	_, _, err = r.Exec(ctx, log.NewNopLogger(), newDeadLetterTestAlert("a1"))
+	require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	require.Error(t, err)
+
+	// The notification which timed out is kept with the last error of the
+	// integration.
+	entries := s.List()
+	require.Len(t, entries, 1)
+	require.Equal(t, ServerErrorReason.String(), entries[0].Reason)
+	require.Contains(t, entries[0].Error, "unexpected status code 503")
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+const (
+	// https://developers.google.com/chat/api/guides/message-formats - 4096 characters or runes.
+	maxTextLenRunes = 4096
+	// maxHeaderLenRunes is the maximum length of the titles and headers of
+	// the cards, which are displayed on a single line.
+	maxHeaderLenRunes = 256
+)
+
+// Notifier implements a Notifier for Google Chat notifications.
+type Notifier struct {
+	conf    *config.GoogleChatConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Google Chat notifier.
+func New(c *config.GoogleChatConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "googlechat", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{RetryCodes: []int{http.StatusTooManyRequests}},
+	}, nil
+}
+
+type message struct {
+	Text    string   `json:"text,omitempty"`
+	CardsV2 []cardV2 `json:"cardsV2,omitempty"`
+	Thread  *thread  `json:"thread,omitempty"`
+}
+
+type thread struct {
+	ThreadKey string `json:"threadKey"`
+}
+
+type cardV2 struct {
+	CardID string `json:"cardId"`
+	Card   card   `json:"card"`
+}
+
+type card struct {
+	Header   *cardHeader `json:"header,omitempty"`
+	Sections []section   `json:"sections"`
+}
+
+type cardHeader struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+type section struct {
+	Header      string   `json:"header,omitempty"`
+	Collapsible bool     `json:"collapsible,omitempty"`
+	Widgets     []widget `json:"widgets"`
+}
+
+type widget struct {
+	TextParagraph textParagraph `json:"textParagraph"`
+}
+
+type textParagraph struct {
+	Text string `json:"text"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+	level.Debug(logger).Log("msg", "sending Google Chat message")
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	truncate := func(name, s string, n int) string {
+		s, truncated := notify.TruncateInRunes(s, n)
+		if truncated {
+			level.Warn(logger).Log("msg", "Truncated "+name, "max_runes", n)
+		}
+		return s
+	}
+
+	msg := message{
+		Text: truncate("text", tmpl(n.conf.Text), maxTextLenRunes),
+	}
+	c := card{Sections: make([]section, 0, len(n.conf.Sections))}
+	if title := tmpl(n.conf.Title); title != "" {
+		c.Header = &cardHeader{
+			Title:    truncate("title", title, maxHeaderLenRunes),
+			Subtitle: truncate("subtitle", tmpl(n.conf.Subtitle), maxHeaderLenRunes),
+		}
+	}
+	for _, s := range n.conf.Sections {
+		c.Sections = append(c.Sections, section{
+			Header:      truncate("section header", tmpl(s.Header), maxHeaderLenRunes),
+			Collapsible: s.Collapsible,
+			Widgets: []widget{{
+				TextParagraph: textParagraph{Text: truncate("section text", tmpl(s.Text), maxTextLenRunes)},
+			}},
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+	if c.Header != nil || len(c.Sections) > 0 {
+		msg.CardsV2 = []cardV2{{CardID: "alertmanager", Card: c}}
+	}
+
+	var u string
+	if n.conf.WebhookURL != nil {
+		u = n.conf.WebhookURL.String()
+	} else {
+		content, err := os.ReadFile(n.conf.WebhookURLFile)
+		if err != nil {
+			return false, err
+		}
+		u = strings.TrimSpace(string(content))
+	}
+
+	if n.conf.Threading {
+		// The thread is keyed on the group key so that the notifications
+		// of the group are replies to the first one.
+		msg.Thread = &thread{ThreadKey: key.Hash()}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return false, notify.RedactURL(err)
+		}
+		q := parsed.Query()
+		q.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+		parsed.RawQuery = q.Encode()
+		u = parsed.String()
+	}
+
+	var payload bytes.Buffer
+	if err = json.NewEncoder(&payload).Encode(msg); err != nil {
+		return false, err
+	}
+
+	resp, err := notify.PostJSON(ctx, n.client, u, &payload)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
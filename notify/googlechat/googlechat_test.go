@@ -0,0 +1,164 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestGoogleChatRetry(t *testing.T) {
+	notifier, err := New(
+		&config.GoogleChatConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestGoogleChatRedactedURL(t *testing.T) {
+	ctx, u, fn := test.GetContextWithCancelingURL()
+	defer fn()
+
+	secret := "secret"
+	u.RawQuery = url.Values{"key": {secret}}.Encode()
+	notifier, err := New(
+		&config.GoogleChatConfig{
+			WebhookURL: &config.SecretURL{URL: u},
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	test.AssertNotifyLeaksNoSecret(ctx, t, notifier, secret)
+}
+
+func TestGoogleChatMessage(t *testing.T) {
+	var (
+		got   message
+		query url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		got = message{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	// The webhook URL is read from a file.
+	f := filepath.Join(t.TempDir(), "webhook_url")
+	require.NoError(t, os.WriteFile(f, []byte(srv.URL+"/v1/spaces/AAA/messages?key=k&token=t\n"), 0o600))
+
+	cfg := config.DefaultGoogleChatConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.WebhookURLFile = f
+	cfg.Subtitle = `{{ .CommonLabels.team }}`
+	cfg.Sections = []*config.GoogleChatSection{
+		{Header: "Alerts", Text: `{{ template "__text_alert_list" .Alerts }}`},
+		{Header: "Runbook", Text: strings.Repeat("x", maxTextLenRunes+1), Collapsible: true},
+	}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency", "team": "payments"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "k", query.Get("key"))
+	require.Empty(t, query.Get("messageReplyOption"))
+	require.Nil(t, got.Thread)
+	require.Empty(t, got.Text)
+	require.Len(t, got.CardsV2, 1)
+	c := got.CardsV2[0].Card
+	require.Contains(t, c.Header.Title, "[FIRING:1]")
+	require.Equal(t, "payments", c.Header.Subtitle)
+	require.Len(t, c.Sections, 2)
+	require.Equal(t, "Alerts", c.Sections[0].Header)
+	require.Contains(t, c.Sections[0].Widgets[0].TextParagraph.Text, "alertname = HighLatency")
+	require.True(t, c.Sections[1].Collapsible)
+	require.Len(t, []rune(c.Sections[1].Widgets[0].TextParagraph.Text), maxTextLenRunes)
+
+	// With threading, the messages of the group are replies in the same
+	// thread.
+	cfg.Threading = true
+	notifier, err = New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Equal(t, "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD", query.Get("messageReplyOption"))
+	require.Equal(t, "t", query.Get("token"))
+	require.Equal(t, &thread{ThreadKey: notify.Key("1").Hash()}, got.Thread)
+}
+
+func TestGoogleChatTemplatingError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultGoogleChatConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.WebhookURL = &config.SecretURL{URL: u}
+	cfg.Sections = []*config.GoogleChatSection{{Text: "{{ "}}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.EqualError(t, err, "template: :1: unclosed action")
+	require.False(t, retry)
+}
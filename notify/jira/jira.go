@@ -0,0 +1,292 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+const (
+	// maxSummaryLenRunes is the maximum length of the summary of an issue.
+	maxSummaryLenRunes = 255
+	// maxTextLenRunes is the maximum length of the description of an issue
+	// and of its comments.
+	maxTextLenRunes = 32767
+)
+
+// Notifier implements a Notifier for Jira notifications.
+type Notifier struct {
+	conf    *config.JiraConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Jira notifier.
+func New(c *config.JiraConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "jira", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{RetryCodes: []int{http.StatusTooManyRequests}},
+	}, nil
+}
+
+type issue struct {
+	Key    string                 `json:"key,omitempty"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+type searchRequest struct {
+	JQL        string   `json:"jql"`
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields"`
+}
+
+type searchResponse struct {
+	Issues []issue `json:"issues"`
+}
+
+type comment struct {
+	Body string `json:"body"`
+}
+
+type transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+}
+
+type transitionsResponse struct {
+	Transitions []transition `json:"transitions"`
+}
+
+type transitionRequest struct {
+	Transition transition `json:"transition"`
+}
+
+// Notify implements the Notifier interface. An issue is created for each
+// aggregation group, which is then commented on by later notifications until
+// the group is resolved.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	project := tmpl(n.conf.Project)
+	if err != nil {
+		return false, fmt.Errorf("project template: %w", err)
+	}
+
+	// Issues are labelled with the hash of the group key so that they can be
+	// found again by later notifications.
+	groupLabel := fmt.Sprintf("ALERT{%s}", key.Hash())
+	existing, retry, err := n.searchIssue(ctx, project, groupLabel)
+	if err != nil {
+		return retry, err
+	}
+
+	if types.Alerts(as...).Status() == model.AlertResolved {
+		if existing == nil {
+			level.Debug(logger).Log("msg", "no open issue to resolve")
+			return false, nil
+		}
+		if n.conf.ResolveTransition == "" {
+			return false, nil
+		}
+		return n.transitionIssue(ctx, existing.Key, n.conf.ResolveTransition)
+	}
+
+	if existing != nil {
+		body, truncated := notify.TruncateInRunes(tmpl(n.conf.Comment), maxTextLenRunes)
+		if err != nil {
+			return false, fmt.Errorf("comment template: %w", err)
+		}
+		if truncated {
+			level.Warn(logger).Log("msg", "Truncated comment", "max_runes", maxTextLenRunes)
+		}
+		level.Debug(logger).Log("msg", "commenting on existing issue", "issue", existing.Key)
+		return n.do(ctx, http.MethodPost, "rest/api/2/issue/"+existing.Key+"/comment", comment{Body: body}, nil)
+	}
+
+	fields := n.issueFields(tmpl, groupLabel, logger)
+	if err != nil {
+		return false, fmt.Errorf("issue template: %w", err)
+	}
+	fields["project"] = map[string]string{"key": project}
+
+	var created issue
+	retry, err = n.do(ctx, http.MethodPost, "rest/api/2/issue", issue{Fields: fields}, &created)
+	if err != nil {
+		return retry, err
+	}
+	level.Debug(logger).Log("msg", "created issue", "issue", created.Key)
+	return false, nil
+}
+
+// issueFields returns the fields of a new issue.
+func (n *Notifier) issueFields(tmpl func(string) string, groupLabel string, logger log.Logger) map[string]interface{} {
+	fields := make(map[string]interface{}, len(n.conf.Fields)+6)
+	for k, v := range n.conf.Fields {
+		fields[k] = tmplField(tmpl, v)
+	}
+
+	summary, truncated := notify.TruncateInRunes(tmpl(n.conf.Summary), maxSummaryLenRunes)
+	if truncated {
+		level.Warn(logger).Log("msg", "Truncated summary", "max_runes", maxSummaryLenRunes)
+	}
+	description, truncated := notify.TruncateInRunes(tmpl(n.conf.Description), maxTextLenRunes)
+	if truncated {
+		level.Warn(logger).Log("msg", "Truncated description", "max_runes", maxTextLenRunes)
+	}
+	fields["summary"] = summary
+	fields["description"] = description
+	fields["issuetype"] = map[string]string{"name": tmpl(n.conf.IssueType)}
+	if p := tmpl(n.conf.Priority); p != "" {
+		fields["priority"] = map[string]string{"name": p}
+	}
+
+	labels := make([]string, 0, len(n.conf.Labels)+1)
+	for _, l := range n.conf.Labels {
+		if l = tmpl(l); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	fields["labels"] = append(labels, groupLabel)
+	return fields
+}
+
+// tmplField templates the strings of a field value.
+func tmplField(tmpl func(string) string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return tmpl(v)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = tmplField(tmpl, e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = tmplField(tmpl, e)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// searchIssue returns the most recent issue of the project which has the
+// given label and isn't done, nil if there is none.
+func (n *Notifier) searchIssue(ctx context.Context, project, label string) (*issue, bool, error) {
+	req := searchRequest{
+		JQL:        fmt.Sprintf(`project = %s AND labels = %s AND statusCategory != Done ORDER BY created DESC`, quoteJQL(project), quoteJQL(label)),
+		MaxResults: 1,
+		Fields:     []string{"status"},
+	}
+	var res searchResponse
+	if retry, err := n.do(ctx, http.MethodPost, "rest/api/2/search", req, &res); err != nil {
+		return nil, retry, err
+	}
+	if len(res.Issues) == 0 {
+		return nil, false, nil
+	}
+	return &res.Issues[0], false, nil
+}
+
+// transitionIssue applies the transition with the given name, or leading to
+// the status with the given name, to the issue.
+func (n *Notifier) transitionIssue(ctx context.Context, key, name string) (bool, error) {
+	path := "rest/api/2/issue/" + key + "/transitions"
+	var res transitionsResponse
+	if retry, err := n.do(ctx, http.MethodGet, path, nil, &res); err != nil {
+		return retry, err
+	}
+	for _, t := range res.Transitions {
+		if strings.EqualFold(t.Name, name) || strings.EqualFold(t.To.Name, name) {
+			return n.do(ctx, http.MethodPost, path, transitionRequest{Transition: transition{ID: t.ID}}, nil)
+		}
+	}
+	return false, fmt.Errorf("no transition %q available for issue %s", name, key)
+}
+
+// do sends a request to the Jira REST API and decodes the response into out
+// if it isn't nil.
+func (n *Notifier) do(ctx context.Context, method, path string, in, out interface{}) (bool, error) {
+	u := n.conf.APIURL.JoinPath(path).String()
+
+	var resp *http.Response
+	var err error
+	if method == http.MethodGet {
+		resp, err = notify.Get(ctx, n.client, u)
+	} else {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(in); err != nil {
+			return false, err
+		}
+		resp, err = notify.PostJSON(ctx, n.client, u, &buf)
+	}
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	if retry, err := n.retrier.Check(resp.StatusCode, resp.Body); err != nil {
+		return retry, err
+	}
+	if out == nil {
+		return false, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return false, nil
+}
+
+// quoteJQL quotes a string for use in a JQL query.
+func quoteJQL(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeJira is a stand-in for the Jira REST API.
+type fakeJira struct {
+	mtx      sync.Mutex
+	issues   []*fakeIssue
+	comments map[string][]string
+}
+
+type fakeIssue struct {
+	key    string
+	status string
+	fields map[string]interface{}
+}
+
+func newFakeJira() *fakeJira {
+	return &fakeJira{comments: map[string][]string{}}
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/rest/api/2/")
+	switch {
+	case path == "search" && r.Method == http.MethodPost:
+		var req searchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := searchResponse{Issues: []issue{}}
+		for i := len(f.issues) - 1; i >= 0; i-- {
+			is := f.issues[i]
+			if is.status == "Done" {
+				continue
+			}
+			for _, l := range is.fields["labels"].([]interface{}) {
+				if strings.Contains(req.JQL, fmt.Sprintf("labels = %q", l)) {
+					res.Issues = append(res.Issues, issue{Key: is.key})
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+	case path == "issue" && r.Method == http.MethodPost:
+		var req issue
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		is := &fakeIssue{key: fmt.Sprintf("OPS-%d", len(f.issues)+1), status: "Open", fields: req.Fields}
+		f.issues = append(f.issues, is)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(issue{Key: is.key})
+	case strings.HasSuffix(path, "/comment") && r.Method == http.MethodPost:
+		var req comment
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		key := strings.TrimSuffix(strings.TrimPrefix(path, "issue/"), "/comment")
+		f.comments[key] = append(f.comments[key], req.Body)
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasSuffix(path, "/transitions"):
+		key := strings.TrimSuffix(strings.TrimPrefix(path, "issue/"), "/transitions")
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"transitions":[{"id":"11","name":"Start","to":{"name":"In Progress"}},{"id":"31","name":"Close","to":{"name":"Done"}}]}`)
+			return
+		}
+		var req transitionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, is := range f.issues {
+			if is.key == key && req.Transition.ID == "31" {
+				is.status = "Done"
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestJiraRetry(t *testing.T) {
+	notifier, err := New(
+		&config.JiraConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestJiraIssueLifecycle(t *testing.T) {
+	jira := newFakeJira()
+	srv := httptest.NewServer(jira)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultJiraConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.APIURL = &config.URL{URL: u}
+	cfg.Project = `{{ .CommonLabels.project }}`
+	cfg.IssueType = "Bug"
+	cfg.Priority = `{{ if eq .CommonLabels.severity "critical" }}High{{ end }}`
+	cfg.Labels = []string{"alertmanager", `{{ .CommonLabels.severity }}`}
+	cfg.Fields = map[string]interface{}{
+		"customfield_10000": map[string]interface{}{"value": `{{ .CommonLabels.alertname }}`},
+		"customfield_10001": 3,
+	}
+	cfg.ResolveTransition = "done"
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "HighLatency",
+				"project":   "OPS",
+				"severity":  "critical",
+			},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	}
+
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+	require.Len(t, jira.issues, 1)
+	fields := jira.issues[0].fields
+	require.Equal(t, map[string]interface{}{"key": "OPS"}, fields["project"])
+	require.Equal(t, map[string]interface{}{"name": "Bug"}, fields["issuetype"])
+	require.Equal(t, map[string]interface{}{"name": "High"}, fields["priority"])
+	require.Equal(t, []interface{}{"alertmanager", "critical", "ALERT{" + notify.Key("1").Hash() + "}"}, fields["labels"])
+	require.Equal(t, map[string]interface{}{"value": "HighLatency"}, fields["customfield_10000"])
+	require.Equal(t, float64(3), fields["customfield_10001"])
+	require.Contains(t, fields["summary"], "[FIRING:1]")
+	require.Contains(t, fields["description"], "alertname = HighLatency")
+
+	// Repeated notifications comment on the existing issue.
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, jira.issues, 1)
+	require.Len(t, jira.comments["OPS-1"], 1)
+	require.Contains(t, jira.comments["OPS-1"][0], "alertname = HighLatency")
+
+	// Other groups get their own issue.
+	_, err = notifier.Notify(notify.WithGroupKey(context.Background(), "2"), alert)
+	require.NoError(t, err)
+	require.Len(t, jira.issues, 2)
+
+	// The resolved group transitions its issue.
+	resolved := *alert
+	resolved.EndsAt = time.Now().Add(-time.Minute)
+	_, err = notifier.Notify(ctx, &resolved)
+	require.NoError(t, err)
+	require.Equal(t, "Done", jira.issues[0].status)
+	require.Equal(t, "Open", jira.issues[1].status)
+
+	// Once the issue is done, a new one is created when the group fires again.
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, jira.issues, 3)
+	require.Len(t, jira.comments["OPS-1"], 1)
+}
+
+func TestJiraUnknownTransition(t *testing.T) {
+	jira := newFakeJira()
+	srv := httptest.NewServer(jira)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultJiraConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.APIURL = &config.URL{URL: u}
+	cfg.Project = "OPS"
+	cfg.IssueType = "Bug"
+	cfg.ResolveTransition = "Archived"
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now().Add(-time.Hour),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	}
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+
+	alert.EndsAt = time.Now().Add(-time.Minute)
+	retry, err := notifier.Notify(ctx, alert)
+	require.EqualError(t, err, `no transition "Archived" available for issue OPS-1`)
+	require.False(t, retry)
+}
+
+func TestJiraTemplatingError(t *testing.T) {
+	jira := newFakeJira()
+	srv := httptest.NewServer(jira)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultJiraConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.APIURL = &config.URL{URL: u}
+	cfg.Project = "OPS"
+	cfg.IssueType = "Bug"
+	cfg.Summary = "{{ "
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.ErrorContains(t, err, "template: :1: unclosed action")
+	require.False(t, retry)
+	require.Empty(t, jira.issues)
+}
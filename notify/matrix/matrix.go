@@ -0,0 +1,160 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// maxBodyLenBytes is the maximum size of the plain-text and formatted bodies
+// of a message, so that both fit in an event of at most 65536 bytes.
+const maxBodyLenBytes = 30000
+
+// Notifier implements a Notifier for Matrix notifications.
+type Notifier struct {
+	conf    *config.MatrixConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Matrix notifier.
+func New(c *config.MatrixConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	// The access token is sent as a bearer token, the file being read again
+	// on every request.
+	httpConfig := *c.HTTPConfig
+	httpConfig.Authorization = &commoncfg.Authorization{
+		Type:            "Bearer",
+		Credentials:     commoncfg.Secret(c.AccessToken),
+		CredentialsFile: c.AccessTokenFile,
+	}
+	client, err := commoncfg.NewClientFromConfig(httpConfig, "matrix", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:   c,
+		tmpl:   t,
+		logger: l,
+		client: client,
+		retrier: &notify.Retrier{
+			RetryCodes:        []int{http.StatusTooManyRequests},
+			CustomDetailsFunc: errDetails,
+		},
+	}, nil
+}
+
+type message struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+type errorResponse struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	tmplHTML := notify.TmplHTML(n.tmpl, data, &err)
+
+	body, truncated := notify.TruncateInBytes(tmpl(n.conf.Message), maxBodyLenBytes)
+	if truncated {
+		level.Warn(logger).Log("msg", "Truncated message", "max_bytes", maxBodyLenBytes)
+	}
+	msg := message{
+		MsgType: n.conf.MessageType,
+		Body:    body,
+	}
+	if html := tmplHTML(n.conf.HTMLMessage); html != "" {
+		// Truncating could leave unbalanced tags, which Matrix clients are
+		// expected to cope with.
+		html, truncated = notify.TruncateInBytes(html, maxBodyLenBytes)
+		if truncated {
+			level.Warn(logger).Log("msg", "Truncated HTML message", "max_bytes", maxBodyLenBytes)
+		}
+		msg.Format = "org.matrix.custom.html"
+		msg.FormattedBody = html
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var payload bytes.Buffer
+	if err = json.NewEncoder(&payload).Encode(msg); err != nil {
+		return false, err
+	}
+
+	// Retries of a notification reuse the same transaction ID, which the
+	// homeserver uses to deduplicate the message.
+	now, ok := notify.Now(ctx)
+	if !ok {
+		now = time.Now()
+	}
+	txnID := fmt.Sprintf("%s-%d", key.Hash()[:16], now.UnixNano())
+	u := n.conf.HomeserverURL.JoinPath("_matrix/client/v3/rooms", n.conf.RoomID, "send/m.room.message", txnID)
+
+	resp, err := notify.PutJSON(ctx, n.client, u.String(), &payload)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	shouldRetry, err := n.retrier.Check(resp.StatusCode, resp.Body)
+	if err != nil {
+		return shouldRetry, err
+	}
+	level.Debug(logger).Log("msg", "Matrix message successfully sent", "room_id", n.conf.RoomID)
+	return false, nil
+}
+
+// errDetails returns the error code and message of a response of the
+// client-server API.
+func errDetails(_ int, body io.Reader) string {
+	if body == nil {
+		return ""
+	}
+	var e errorResponse
+	if err := json.NewDecoder(body).Decode(&e); err != nil || e.ErrCode == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", e.ErrCode, e.Error)
+}
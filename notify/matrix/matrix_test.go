@@ -0,0 +1,151 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestMatrixRetry(t *testing.T) {
+	notifier, err := New(
+		&config.MatrixConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestMatrixNotify(t *testing.T) {
+	var (
+		got           message
+		method, path  string
+		authorization string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		got = message{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		fmt.Fprint(w, `{"event_id":"$1"}`)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultMatrixConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.HomeserverURL = &config.URL{URL: u}
+	cfg.RoomID = "!room:example.org"
+	cfg.AccessToken = "token"
+	cfg.HTMLMessage = `<b>{{ .CommonLabels.alertname }}</b> {{ .CommonAnnotations.summary }}`
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	now := time.Now()
+	ctx := notify.WithNow(notify.WithGroupKey(context.Background(), "1"), now)
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:      model.LabelSet{"alertname": "HighLatency"},
+			Annotations: model.LabelSet{"summary": "p99 > 1s & rising"},
+			StartsAt:    now,
+			EndsAt:      now.Add(time.Hour),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, http.MethodPut, method)
+	require.Equal(t, fmt.Sprintf("/_matrix/client/v3/rooms/!room:example.org/send/m.room.message/%s-%d", notify.Key("1").Hash()[:16], now.UnixNano()), path)
+	require.Equal(t, "Bearer token", authorization)
+	require.Equal(t, "m.text", got.MsgType)
+	require.Contains(t, got.Body, "[FIRING:1]")
+	require.Contains(t, got.Body, "alertname = HighLatency")
+	require.Equal(t, "org.matrix.custom.html", got.Format)
+	require.Equal(t, "<b>HighLatency</b> p99 &gt; 1s &amp; rising", got.FormattedBody)
+
+	// Without an HTML message, only the plain-text body is sent and the
+	// token is read from its file.
+	f := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(f, []byte("file-token"), 0o600))
+	cfg.AccessToken = ""
+	cfg.AccessTokenFile = f
+	cfg.HTMLMessage = ""
+	cfg.MessageType = "m.notice"
+	notifier, err = New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer file-token", authorization)
+	require.Equal(t, "m.notice", got.MsgType)
+	require.Empty(t, got.Format)
+	require.Empty(t, got.FormattedBody)
+}
+
+func TestMatrixErrorDetails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errcode":"M_FORBIDDEN","error":"You are not in this room."}`)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultMatrixConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.HomeserverURL = &config.URL{URL: u}
+	cfg.RoomID = "!room:example.org"
+	cfg.AccessToken = "secret-token"
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.EqualError(t, err, "unexpected status code 403: M_FORBIDDEN: You are not in this room.")
+	require.False(t, retry)
+	require.NotContains(t, err.Error(), "secret-token")
+}
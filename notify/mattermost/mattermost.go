@@ -0,0 +1,176 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mattermost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// https://developers.mattermost.com/integrate/reference/message-attachments/ - posts
+// are limited to 16383 characters or runes.
+const maxTextLenRunes = 16383
+
+// Notifier implements a Notifier for Mattermost notifications.
+type Notifier struct {
+	conf    *config.MattermostConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Mattermost notifier.
+func New(c *config.MattermostConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "mattermost", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{RetryCodes: []int{http.StatusTooManyRequests}},
+	}, nil
+}
+
+// request is the payload of a Mattermost incoming webhook.
+type request struct {
+	Channel     string       `json:"channel,omitempty"`
+	Username    string       `json:"username,omitempty"`
+	IconURL     string       `json:"icon_url,omitempty"`
+	IconEmoji   string       `json:"icon_emoji,omitempty"`
+	Attachments []attachment `json:"attachments"`
+	Props       *props       `json:"props,omitempty"`
+	Priority    *priority    `json:"priority,omitempty"`
+}
+
+type attachment struct {
+	Fallback  string              `json:"fallback,omitempty"`
+	Color     string              `json:"color,omitempty"`
+	Pretext   string              `json:"pretext,omitempty"`
+	Title     string              `json:"title,omitempty"`
+	TitleLink string              `json:"title_link,omitempty"`
+	Text      string              `json:"text,omitempty"`
+	Fields    []config.SlackField `json:"fields,omitempty"`
+	Footer    string              `json:"footer,omitempty"`
+}
+
+type props struct {
+	Card string `json:"card"`
+}
+
+type priority struct {
+	Priority string `json:"priority"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	truncate := func(name, s string) string {
+		s, truncated := notify.TruncateInRunes(s, maxTextLenRunes)
+		if truncated {
+			level.Warn(logger).Log("msg", "Truncated "+name, "max_runes", maxTextLenRunes)
+		}
+		return s
+	}
+
+	att := attachment{
+		Fallback:  tmpl(n.conf.Fallback),
+		Color:     tmpl(n.conf.Color),
+		Pretext:   tmpl(n.conf.Pretext),
+		Title:     tmpl(n.conf.Title),
+		TitleLink: tmpl(n.conf.TitleLink),
+		Text:      truncate("text", tmpl(n.conf.Text)),
+		Footer:    tmpl(n.conf.Footer),
+	}
+	if len(n.conf.Fields) > 0 {
+		att.Fields = make([]config.SlackField, len(n.conf.Fields))
+		for i, f := range n.conf.Fields {
+			// Fields without short fall back to the setting of the config.
+			short := n.conf.ShortFields
+			if f.Short != nil {
+				short = *f.Short
+			}
+			att.Fields[i] = config.SlackField{
+				Title: tmpl(f.Title),
+				Value: tmpl(f.Value),
+				Short: &short,
+			}
+		}
+	}
+
+	req := request{
+		Channel:     tmpl(n.conf.Channel),
+		Username:    tmpl(n.conf.Username),
+		IconURL:     tmpl(n.conf.IconURL),
+		IconEmoji:   tmpl(n.conf.IconEmoji),
+		Attachments: []attachment{att},
+	}
+	if card := tmpl(n.conf.Card); card != "" {
+		req.Props = &props{Card: truncate("card", card)}
+	}
+	if n.conf.Priority != "" {
+		req.Priority = &priority{Priority: n.conf.Priority}
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(req); err != nil {
+		return false, err
+	}
+
+	var u string
+	if n.conf.WebhookURL != nil {
+		u = n.conf.WebhookURL.String()
+	} else {
+		content, err := os.ReadFile(n.conf.WebhookURLFile)
+		if err != nil {
+			return false, err
+		}
+		u = strings.TrimSpace(string(content))
+	}
+
+	resp, err := notify.PostJSON(ctx, n.client, u, &buf)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestMattermostRetry(t *testing.T) {
+	notifier, err := New(
+		&config.MattermostConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestMattermostRedactedURL(t *testing.T) {
+	ctx, u, fn := test.GetContextWithCancelingURL()
+	defer fn()
+
+	secret := "secret"
+	u.Path = "/hooks/" + secret
+	notifier, err := New(
+		&config.MattermostConfig{
+			WebhookURL: &config.SecretURL{URL: u},
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	test.AssertNotifyLeaksNoSecret(ctx, t, notifier, secret)
+}
+
+func TestMattermostPayload(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	f := filepath.Join(t.TempDir(), "webhook_url")
+	require.NoError(t, os.WriteFile(f, []byte(srv.URL+"/hooks/xxx\n"), 0o600))
+
+	short := false
+	cfg := config.DefaultMattermostConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.WebhookURLFile = f
+	cfg.Channel = `{{ .CommonLabels.team }}-alerts`
+	cfg.Username = "alertmanager"
+	cfg.IconURL = "https://example.org/icon.png"
+	cfg.Card = `### {{ .CommonLabels.alertname }}`
+	cfg.Priority = "urgent"
+	cfg.ShortFields = true
+	cfg.Fields = []*config.SlackField{
+		{Title: "Team", Value: `{{ .CommonLabels.team }}`},
+		{Title: "Severity", Value: `{{ .CommonLabels.severity }}`, Short: &short},
+	}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency", "team": "payments", "severity": "critical"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "payments-alerts", got["channel"])
+	require.Equal(t, "alertmanager", got["username"])
+	require.Equal(t, "https://example.org/icon.png", got["icon_url"])
+	require.Equal(t, map[string]interface{}{"card": "### HighLatency"}, got["props"])
+	require.Equal(t, map[string]interface{}{"priority": "urgent"}, got["priority"])
+	att := got["attachments"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "#A30200", att["color"])
+	require.Contains(t, att["title"], "[FIRING:1]")
+	require.Equal(t, "http://am/#/alerts?receiver=", att["title_link"])
+	require.Contains(t, att["text"], "alertname = HighLatency")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"title": "Team", "value": "payments", "short": true},
+		map[string]interface{}{"title": "Severity", "value": "critical", "short": false},
+	}, att["fields"])
+
+	// Empty cards and priorities are left out.
+	cfg.Card = ""
+	cfg.Priority = ""
+	notifier, err = New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.NotContains(t, got, "props")
+	require.NotContains(t, got, "priority")
+}
+
+func TestMattermostTemplatingError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultMattermostConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.WebhookURL = &config.SecretURL{URL: u}
+	cfg.Fields = []*config.SlackField{{Title: "Team", Value: "{{ "}}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.EqualError(t, err, "template: :1: unclosed action")
+	require.False(t, retry)
+}
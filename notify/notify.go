@@ -28,6 +28,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/featurecontrol"
 	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/nflog"
@@ -42,6 +43,12 @@ type ResolvedSender interface {
 	SendResolved() bool
 }
 
+// RetryConfigurer returns the retry configuration of an integration, nil if
+// the defaults apply.
+type RetryConfigurer interface {
+	RetryConfig() *config.RetryConfig
+}
+
 // Peer represents the cluster node from where we are the sending the notification.
 type Peer interface {
 	// WaitReady waits until the node silences and notifications have settled before attempting to send a notification.
@@ -90,6 +97,15 @@ func (i *Integration) SendResolved() bool {
 	return i.rs.SendResolved()
 }
 
+// RetryConfig returns the retry configuration of the integration, nil if the
+// defaults apply.
+func (i *Integration) RetryConfig() *config.RetryConfig {
+	if rc, ok := i.rs.(RetryConfigurer); ok {
+		return rc.RetryConfig()
+	}
+	return nil
+}
+
 // Name returns the name of the integration.
 func (i *Integration) Name() string {
 	return i.name
@@ -344,6 +360,12 @@ func (m *Metrics) InitializeFor(receiver map[string][]Integration) {
 		"discord",
 		"webex",
 		"msteams",
+		"jira",
+		"servicenow",
+		"googlechat",
+		"matrix",
+		"mattermost",
+		"rocketchat",
 	} {
 		m.numNotifications.WithLabelValues(integration)
 		m.numNotificationRequestsTotal.WithLabelValues(integration)
@@ -375,7 +397,10 @@ func (pb *PipelineBuilder) New(
 	inhibitor *inhibit.Inhibitor,
 	silencer *silence.Silencer,
 	intervener *timeinterval.Intervener,
+	marker types.Marker,
 	notificationLog NotificationLog,
+	deadLetters *DeadLetterStore,
+	breakers *CircuitBreakers,
 	peer Peer,
 ) RoutingStage {
 	rs := make(RoutingStage, len(receivers))
@@ -387,11 +412,12 @@ func (pb *PipelineBuilder) New(
 	ss := NewMuteStage(silencer)
 
 	for name := range receivers {
-		st := createReceiverStage(name, receivers[name], wait, notificationLog, pb.metrics)
+		st := createReceiverStage(name, receivers[name], wait, marker, notificationLog, deadLetters, breakers, pb.metrics)
 		rs[name] = MultiStage{ms, is, tas, tms, ss, st}
 	}
 
 	pb.metrics.InitializeFor(receivers)
+	breakers.Sync(receivers)
 
 	return rs
 }
@@ -401,7 +427,10 @@ func createReceiverStage(
 	name string,
 	integrations []Integration,
 	wait func() time.Duration,
+	marker types.Marker,
 	notificationLog NotificationLog,
+	deadLetters *DeadLetterStore,
+	breakers *CircuitBreakers,
 	metrics *Metrics,
 ) Stage {
 	var fs FanoutStage
@@ -413,8 +442,8 @@ func createReceiverStage(
 		}
 		var s MultiStage
 		s = append(s, NewWaitStage(wait))
-		s = append(s, NewDedupStage(&integrations[i], notificationLog, recv))
-		s = append(s, NewRetryStage(integrations[i], name, metrics))
+		s = append(s, NewDedupStage(&integrations[i], notificationLog, recv, marker))
+		s = append(s, NewRetryStage(integrations[i], name, metrics, deadLetters, breakers.Get(name, &integrations[i])))
 		s = append(s, NewSetNotifiesStage(notificationLog, recv))
 
 		fs = append(fs, s)
@@ -563,24 +592,28 @@ func (ws *WaitStage) Exec(ctx context.Context, _ log.Logger, alerts ...*types.Al
 }
 
 // DedupStage filters alerts.
-// Filtering happens based on a notification log.
+// Filtering happens based on a notification log. If a marker is set, alerts
+// whose firing alerts are all acknowledged aren't notified again once the
+// repeat interval has passed.
 type DedupStage struct {
-	rs    ResolvedSender
-	nflog NotificationLog
-	recv  *nflogpb.Receiver
+	rs     ResolvedSender
+	nflog  NotificationLog
+	recv   *nflogpb.Receiver
+	marker types.Marker
 
 	now  func() time.Time
 	hash func(*types.Alert) uint64
 }
 
 // NewDedupStage wraps a DedupStage that runs against the given notification log.
-func NewDedupStage(rs ResolvedSender, l NotificationLog, recv *nflogpb.Receiver) *DedupStage {
+func NewDedupStage(rs ResolvedSender, l NotificationLog, recv *nflogpb.Receiver, mk types.Marker) *DedupStage {
 	return &DedupStage{
-		rs:    rs,
-		nflog: l,
-		recv:  recv,
-		now:   utcNow,
-		hash:  hashAlert,
+		rs:     rs,
+		nflog:  l,
+		recv:   recv,
+		marker: mk,
+		now:    utcNow,
+		hash:   hashAlert,
 	}
 }
 
@@ -623,7 +656,7 @@ func hashAlert(a *types.Alert) uint64 {
 	return hash
 }
 
-func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint64]struct{}, repeat time.Duration) bool {
+func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint64]struct{}, repeat time.Duration, acked bool) bool {
 	// If we haven't notified about the alert group before, notify right away
 	// unless we only have resolved alerts.
 	if entry == nil {
@@ -649,8 +682,9 @@ func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint
 		return true
 	}
 
-	// Nothing changed, only notify if the repeat interval has passed.
-	return entry.Timestamp.Before(n.now().Add(-repeat))
+	// Nothing changed, only notify if the repeat interval has passed and
+	// the firing alerts haven't all been acknowledged.
+	return !acked && entry.Timestamp.Before(n.now().Add(-repeat))
 }
 
 // Exec implements the Stage interface.
@@ -671,6 +705,7 @@ func (n *DedupStage) Exec(ctx context.Context, _ log.Logger, alerts ...*types.Al
 	resolved := []uint64{}
 
 	var hash uint64
+	acked := n.marker != nil
 	for _, a := range alerts {
 		hash = n.hash(a)
 		if a.Resolved() {
@@ -679,6 +714,9 @@ func (n *DedupStage) Exec(ctx context.Context, _ log.Logger, alerts ...*types.Al
 		} else {
 			firing = append(firing, hash)
 			firingSet[hash] = struct{}{}
+			if acked {
+				_, acked = n.marker.Acknowledged(a.Fingerprint())
+			}
 		}
 	}
 
@@ -699,7 +737,7 @@ func (n *DedupStage) Exec(ctx context.Context, _ log.Logger, alerts ...*types.Al
 		return ctx, nil, fmt.Errorf("unexpected entry result size %d", len(entries))
 	}
 
-	if n.needsUpdate(entry, firingSet, resolvedSet, repeatInterval) {
+	if n.needsUpdate(entry, firingSet, resolvedSet, repeatInterval, acked) {
 		return ctx, alerts, nil
 	}
 	return ctx, nil, nil
@@ -712,10 +750,14 @@ type RetryStage struct {
 	groupName   string
 	metrics     *Metrics
 	labelValues []string
+	deadLetters *DeadLetterStore
+	breaker     *CircuitBreaker
 }
 
-// NewRetryStage returns a new instance of a RetryStage.
-func NewRetryStage(i Integration, groupName string, metrics *Metrics) *RetryStage {
+// NewRetryStage returns a new instance of a RetryStage. The notifications
+// it gives up on are added to the dead-letter store, if not nil. If the
+// circuit breaker is not nil, notifications fail fast while it is open.
+func NewRetryStage(i Integration, groupName string, metrics *Metrics, dl *DeadLetterStore, cb *CircuitBreaker) *RetryStage {
 	labelValues := []string{i.Name()}
 
 	if metrics.ff.EnableReceiverNamesInMetrics() {
@@ -727,22 +769,53 @@ func NewRetryStage(i Integration, groupName string, metrics *Metrics) *RetryStag
 		groupName:   groupName,
 		metrics:     metrics,
 		labelValues: labelValues,
+		deadLetters: dl,
+		breaker:     cb,
 	}
 }
 
 func (r RetryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
 	r.metrics.numNotifications.WithLabelValues(r.labelValues...).Inc()
-	ctx, alerts, err := r.exec(ctx, l, alerts...)
+	ctx, sent, err := r.exec(ctx, l, alerts...)
 
-	failureReason := DefaultReason.String()
 	if err != nil {
-		var e *ErrorWithReason
-		if errors.As(err, &e) {
-			failureReason = e.Reason.String()
+		reason := failureReason(err)
+		r.metrics.numTotalFailedNotifications.WithLabelValues(append(r.labelValues, reason)...).Inc()
+		if r.deadLetters != nil && isDeadLetter(ctx, err) {
+			r.addDeadLetter(ctx, l, reason, err, alerts)
 		}
-		r.metrics.numTotalFailedNotifications.WithLabelValues(append(r.labelValues, failureReason)...).Inc()
 	}
-	return ctx, alerts, err
+	return ctx, sent, err
+}
+
+// isDeadLetter returns whether the failed notification is kept in the
+// dead-letter store. Notifications canceled by a shutdown, or rejected by an
+// open circuit breaker, are attempted again with the next flush of the
+// aggregation group. Notifications which timed out are kept, with the last
+// error of the integration.
+func isDeadLetter(ctx context.Context, err error) bool {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return false
+	}
+	return !errors.Is(err, ErrCircuitOpen)
+}
+
+func (r RetryStage) addDeadLetter(ctx context.Context, l log.Logger, reason string, err error, alerts []*types.Alert) {
+	gkey, _ := GroupKey(ctx)
+	glabels, _ := GroupLabels(ctx)
+	dl := &DeadLetter{
+		Receiver:    r.groupName,
+		Integration: r.integration.Name(),
+		Index:       r.integration.Index(),
+		GroupKey:    gkey,
+		GroupLabels: glabels,
+		Alerts:      alerts,
+		Reason:      reason,
+		Error:       err.Error(),
+	}
+	if err := r.deadLetters.Add(dl); err != nil {
+		level.Error(l).Log("msg", "Failed to add notification to the dead-letter store", "err", err)
+	}
 }
 
 func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
@@ -770,6 +843,13 @@ func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 
 	b := backoff.NewExponentialBackOff()
 	b.MaxElapsedTime = 0 // Always retry.
+	rc := r.integration.RetryConfig()
+	if rc != nil {
+		b.InitialInterval = time.Duration(rc.InitialInterval)
+		b.Multiplier = rc.Multiplier
+		b.MaxInterval = time.Duration(rc.MaxInterval)
+		b.RandomizationFactor = rc.Jitter
+	}
 
 	tick := backoff.NewTicker(b)
 	defer tick.Stop()
@@ -807,9 +887,26 @@ func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 
 		select {
 		case <-tick.C:
+			if r.breaker != nil && !r.breaker.Allow() {
+				err := NewErrorWithReason(CircuitOpenReason, ErrCircuitOpen)
+				if iErr != nil {
+					err = NewErrorWithReason(CircuitOpenReason, fmt.Errorf("%w: %w", ErrCircuitOpen, iErr))
+				}
+				return ctx, nil, fmt.Errorf("%s/%s: notify retry canceled after %d attempts: %w", r.groupName, r.integration.String(), i, err)
+			}
 			now := time.Now()
 			retry, err := r.integration.Notify(ctx, sent...)
 			dur := time.Since(now)
+			if err != nil && rc != nil {
+				retry = retryStatusCode(rc, err, retry)
+			}
+			if r.breaker != nil {
+				// Only retryable failures tell something about the health of
+				// the integration: unrecoverable errors are caused by the
+				// notification itself, and failures caused by the context
+				// being done by its timeout.
+				r.breaker.Done(err, retry && ctx.Err() == nil)
+			}
 			r.metrics.notificationLatencySeconds.WithLabelValues(r.labelValues...).Observe(dur.Seconds())
 			r.metrics.numNotificationRequestsTotal.WithLabelValues(r.labelValues...).Inc()
 			if err != nil {
@@ -817,6 +914,9 @@ func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 				if !retry {
 					return ctx, alerts, fmt.Errorf("%s/%s: notify retry canceled due to unrecoverable error after %d attempts: %w", r.groupName, r.integration.String(), i, err)
 				}
+				if rc != nil && rc.MaxAttempts > 0 && i >= rc.MaxAttempts {
+					return ctx, alerts, fmt.Errorf("%s/%s: notify retry canceled after reaching the maximum of %d attempts: %w", r.groupName, r.integration.String(), i, err)
+				}
 				if ctx.Err() == nil {
 					if iErr == nil || err.Error() != iErr.Error() {
 						// Log the error if the context isn't done and the error isn't the same as before.
@@ -852,6 +952,27 @@ func (r RetryStage) exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 	}
 }
 
+// retryStatusCode returns whether a request which failed with err is retried
+// according to the status codes of the retry configuration. If err isn't
+// caused by a status code listed there, retry is returned unchanged.
+func retryStatusCode(rc *config.RetryConfig, err error, retry bool) bool {
+	var e *ErrorWithStatusCode
+	if !errors.As(err, &e) {
+		return retry
+	}
+	for _, code := range rc.NoRetryStatusCodes {
+		if code == e.StatusCode {
+			return false
+		}
+	}
+	for _, code := range rc.RetryStatusCodes {
+		if code == e.StatusCode {
+			return true
+		}
+	}
+	return retry
+}
+
 // SetNotifiesStage sets the notification information about passed alerts. The
 // passed alerts should have already been sent to the receivers.
 type SetNotifiesStage struct {
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/featurecontrol"
 	"github.com/prometheus/alertmanager/nflog"
 	"github.com/prometheus/alertmanager/nflog/nflogpb"
@@ -98,6 +99,7 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 		resolvedAlerts map[uint64]struct{}
 		repeat         time.Duration
 		resolve        bool
+		acked          bool
 
 		res bool
 	}{
@@ -142,6 +144,36 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 			repeat:       10 * time.Minute,
 			firingAlerts: alertHashSet(1, 2, 3),
 			res:          true,
+		}, {
+			// Acknowledged alerts shouldn't update after repeat_interval.
+			entry: &nflogpb.Entry{
+				FiringAlerts: []uint64{1, 2, 3},
+				Timestamp:    now.Add(-11 * time.Minute),
+			},
+			repeat:       10 * time.Minute,
+			firingAlerts: alertHashSet(1, 2, 3),
+			acked:        true,
+			res:          false,
+		}, {
+			// Acknowledged alerts should update when new alerts fire.
+			entry: &nflogpb.Entry{
+				FiringAlerts: []uint64{1, 2},
+				Timestamp:    now.Add(-9 * time.Minute),
+			},
+			repeat:       10 * time.Minute,
+			firingAlerts: alertHashSet(1, 2, 3),
+			acked:        true,
+			res:          true,
+		}, {
+			// Acknowledged alerts should update when all alerts resolve.
+			entry: &nflogpb.Entry{
+				FiringAlerts: []uint64{1, 2},
+				Timestamp:    now.Add(-9 * time.Minute),
+			},
+			repeat:         10 * time.Minute,
+			resolvedAlerts: alertHashSet(1, 2),
+			acked:          true,
+			res:            true,
 		}, {
 			// Different sets of resolved alerts without firing alerts shouldn't update after repeat_interval.
 			entry: &nflogpb.Entry{
@@ -209,7 +241,7 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 			now: func() time.Time { return now },
 			rs:  sendResolved(c.resolve),
 		}
-		res := s.needsUpdate(c.entry, c.firingAlerts, c.resolvedAlerts, c.repeat)
+		res := s.needsUpdate(c.entry, c.firingAlerts, c.resolvedAlerts, c.repeat, c.acked)
 		require.Equal(t, c.res, res)
 	}
 }
@@ -299,6 +331,48 @@ func TestDedupStage(t *testing.T) {
 	require.Equal(t, alerts, res, "unexpected alerts returned")
 }
 
+func TestDedupStageAcknowledged(t *testing.T) {
+	now := utcNow()
+	marker := types.NewMarker(prometheus.NewRegistry())
+	s := NewDedupStage(sendResolved(false), &testNflog{
+		qres: []*nflogpb.Entry{
+			{
+				FiringAlerts: []uint64{hashAlert(&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "1"}}})},
+				Timestamp:    now.Add(-2 * time.Hour),
+			},
+		},
+	}, nil, marker)
+	s.now = func() time.Time { return now }
+
+	ctx := WithGroupKey(context.Background(), "1")
+	ctx = WithRepeatInterval(ctx, time.Hour)
+
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "1"},
+			StartsAt: now.Add(-3 * time.Hour),
+			EndsAt:   now.Add(time.Hour),
+		},
+	}
+
+	// The repeat interval has passed.
+	_, res, err := s.Exec(ctx, log.NewNopLogger(), alert)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Alert{alert}, res)
+
+	// Acknowledged alerts aren't notified again.
+	marker.SetAcknowledged(alert.Fingerprint(), &types.Acknowledgement{AcknowledgedBy: "me", CreatedAt: now})
+	_, res, err = s.Exec(ctx, log.NewNopLogger(), alert)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// Unless the acknowledgement has expired.
+	marker.SetAcknowledged(alert.Fingerprint(), &types.Acknowledgement{AcknowledgedBy: "me", CreatedAt: now, ExpiresAt: now.Add(-time.Minute)})
+	_, res, err = s.Exec(ctx, log.NewNopLogger(), alert)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Alert{alert}, res)
+}
+
 func TestMultiStage(t *testing.T) {
 	var (
 		alerts1 = []*types.Alert{{}}
@@ -392,7 +466,7 @@ func TestRetryStageWithError(t *testing.T) {
 		}),
 		rs: sendResolved(false),
 	}
-	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
 
 	alerts := []*types.Alert{
 		{
@@ -445,7 +519,7 @@ func TestRetryStageWithErrorCode(t *testing.T) {
 			}),
 			rs: sendResolved(false),
 		}
-		r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+		r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
 
 		alerts := []*types.Alert{
 			{
@@ -480,7 +554,7 @@ func TestRetryStageWithContextCanceled(t *testing.T) {
 		}),
 		rs: sendResolved(false),
 	}
-	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
 
 	alerts := []*types.Alert{
 		{
@@ -502,6 +576,60 @@ func TestRetryStageWithContextCanceled(t *testing.T) {
 	require.NotNil(t, resctx)
 }
 
+func TestRetryStageRetryConfig(t *testing.T) {
+	rc := config.DefaultRetryConfig
+	rc.InitialInterval = model.Duration(time.Millisecond)
+	rc.MaxInterval = model.Duration(time.Millisecond)
+	rc.MaxAttempts = 3
+	rc.RetryStatusCodes = []int{429}
+	rc.NoRetryStatusCodes = []int{503}
+
+	for _, tc := range []struct {
+		name       string
+		statusCode int
+		attempts   int
+	}{
+		{
+			name:       "retried up to the maximum attempts",
+			statusCode: 500,
+			attempts:   3,
+		},
+		{
+			name:       "status code not retried by default is retried",
+			statusCode: 429,
+			attempts:   3,
+		},
+		{
+			name:       "status code retried by default isn't retried",
+			statusCode: 503,
+			attempts:   1,
+		},
+		{
+			name:       "other client errors aren't retried",
+			statusCode: 400,
+			attempts:   1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts int
+			i := Integration{
+				name: "test",
+				notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+					attempts++
+					return (&Retrier{}).Check(tc.statusCode, nil)
+				}),
+				rs: &config.WebhookConfig{NotifierConfig: config.NotifierConfig{VRetryConfig: &rc}},
+			}
+			r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
+
+			ctx := WithFiringAlerts(context.Background(), []uint64{0})
+			_, _, err := r.Exec(ctx, log.NewNopLogger(), &types.Alert{Alert: model.Alert{EndsAt: time.Now().Add(time.Hour)}})
+			require.ErrorContains(t, err, fmt.Sprintf("unexpected status code %d", tc.statusCode))
+			require.Equal(t, tc.attempts, attempts)
+		})
+	}
+}
+
 func TestRetryStageNoResolved(t *testing.T) {
 	sent := []*types.Alert{}
 	i := Integration{
@@ -511,7 +639,7 @@ func TestRetryStageNoResolved(t *testing.T) {
 		}),
 		rs: sendResolved(false),
 	}
-	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
 
 	alerts := []*types.Alert{
 		{
@@ -562,7 +690,7 @@ func TestRetryStageSendResolved(t *testing.T) {
 		}),
 		rs: sendResolved(true),
 	}
-	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}))
+	r := NewRetryStage(i, "", NewMetrics(prometheus.NewRegistry(), featurecontrol.NoopFlags{}), nil, nil)
 
 	alerts := []*types.Alert{
 		{
@@ -721,7 +849,7 @@ func TestMuteStageWithSilences(t *testing.T) {
 	}
 
 	marker := types.NewMarker(prometheus.NewRegistry())
-	silencer := silence.NewSilencer(silences, marker, log.NewNopLogger())
+	silencer := silence.NewSilencer(silences, marker, nil, log.NewNopLogger())
 	stage := NewMuteStage(silencer)
 
 	in := []model.LabelSet{
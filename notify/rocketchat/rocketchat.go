@@ -0,0 +1,157 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketchat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// maxTextLenRunes is the default maximum size of the messages of Rocket.Chat.
+const maxTextLenRunes = 5000
+
+// Notifier implements a Notifier for Rocket.Chat notifications.
+type Notifier struct {
+	conf    *config.RocketChatConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Rocket.Chat notifier.
+func New(c *config.RocketChatConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "rocketchat", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{RetryCodes: []int{http.StatusTooManyRequests}},
+	}, nil
+}
+
+// request is the payload of a Rocket.Chat incoming webhook.
+type request struct {
+	Text        string       `json:"text,omitempty"`
+	Channel     string       `json:"channel,omitempty"`
+	Alias       string       `json:"alias,omitempty"`
+	Avatar      string       `json:"avatar,omitempty"`
+	Emoji       string       `json:"emoji,omitempty"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	Color     string              `json:"color,omitempty"`
+	Title     string              `json:"title,omitempty"`
+	TitleLink string              `json:"title_link,omitempty"`
+	Text      string              `json:"text,omitempty"`
+	Collapsed bool                `json:"collapsed,omitempty"`
+	Fields    []config.SlackField `json:"fields,omitempty"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	truncate := func(name, s string) string {
+		s, truncated := notify.TruncateInRunes(s, maxTextLenRunes)
+		if truncated {
+			level.Warn(logger).Log("msg", "Truncated "+name, "max_runes", maxTextLenRunes)
+		}
+		return s
+	}
+
+	att := attachment{
+		Color:     tmpl(n.conf.Color),
+		Title:     tmpl(n.conf.Title),
+		TitleLink: tmpl(n.conf.TitleLink),
+		Text:      truncate("text", tmpl(n.conf.Text)),
+		Collapsed: n.conf.Collapsed,
+	}
+	if len(n.conf.Fields) > 0 {
+		att.Fields = make([]config.SlackField, len(n.conf.Fields))
+		for i, f := range n.conf.Fields {
+			// Fields without short fall back to the setting of the config.
+			short := n.conf.ShortFields
+			if f.Short != nil {
+				short = *f.Short
+			}
+			att.Fields[i] = config.SlackField{
+				Title: tmpl(f.Title),
+				Value: tmpl(f.Value),
+				Short: &short,
+			}
+		}
+	}
+
+	req := request{
+		Text:        truncate("message", tmpl(n.conf.Message)),
+		Channel:     tmpl(n.conf.Channel),
+		Alias:       tmpl(n.conf.Alias),
+		Avatar:      tmpl(n.conf.Avatar),
+		Emoji:       tmpl(n.conf.Emoji),
+		Attachments: []attachment{att},
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(req); err != nil {
+		return false, err
+	}
+
+	var u string
+	if n.conf.WebhookURL != nil {
+		u = n.conf.WebhookURL.String()
+	} else {
+		content, err := os.ReadFile(n.conf.WebhookURLFile)
+		if err != nil {
+			return false, err
+		}
+		u = strings.TrimSpace(string(content))
+	}
+
+	resp, err := notify.PostJSON(ctx, n.client, u, &buf)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketchat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestRocketChatRetry(t *testing.T) {
+	notifier, err := New(
+		&config.RocketChatConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestRocketChatRedactedURL(t *testing.T) {
+	ctx, u, fn := test.GetContextWithCancelingURL()
+	defer fn()
+
+	secret := "secret"
+	u.Path = "/hooks/xxx/" + secret
+	notifier, err := New(
+		&config.RocketChatConfig{
+			WebhookURL: &config.SecretURL{URL: u},
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	test.AssertNotifyLeaksNoSecret(ctx, t, notifier, secret)
+}
+
+func TestRocketChatPayload(t *testing.T) {
+	var got map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		fmt.Fprint(w, `{"success":true}`)
+	}))
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	short := true
+	cfg := config.DefaultRocketChatConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.WebhookURL = &config.SecretURL{URL: u}
+	cfg.Channel = `#{{ .CommonLabels.team }}`
+	cfg.Alias = "Alertmanager"
+	cfg.Emoji = ":rotating_light:"
+	cfg.Message = strings.Repeat("x", maxTextLenRunes+1)
+	cfg.Collapsed = true
+	cfg.Fields = []*config.SlackField{
+		{Title: "Team", Value: `{{ .CommonLabels.team }}`, Short: &short},
+		{Title: "Alerts", Value: `{{ .Alerts | len }}`},
+	}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency", "team": "payments"},
+			StartsAt: time.Now().Add(-time.Hour),
+			EndsAt:   time.Now().Add(-time.Minute),
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "#payments", got["channel"])
+	require.Equal(t, "Alertmanager", got["alias"])
+	require.Equal(t, ":rotating_light:", got["emoji"])
+	require.NotContains(t, got, "avatar")
+	require.Len(t, []rune(got["text"].(string)), maxTextLenRunes)
+	att := got["attachments"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "#2EB886", att["color"])
+	require.Contains(t, att["title"], "[RESOLVED]")
+	require.Contains(t, att["text"], "alertname = HighLatency")
+	require.Equal(t, true, att["collapsed"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"title": "Team", "value": "payments", "short": true},
+		map[string]interface{}{"title": "Alerts", "value": "1", "short": false},
+	}, att["fields"])
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicenow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+const (
+	// maxShortDescriptionLenRunes is the maximum length of the short
+	// description of an incident.
+	maxShortDescriptionLenRunes = 160
+	// correlationDisplay identifies the records correlated by Alertmanager.
+	correlationDisplay = "Alertmanager"
+)
+
+// Notifier implements a Notifier for ServiceNow notifications.
+type Notifier struct {
+	conf    *config.ServiceNowConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new ServiceNow notifier.
+func New(c *config.ServiceNowConfig, t *template.Template, l log.Logger, httpOpts ...commoncfg.HTTPClientOption) (*Notifier, error) {
+	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "servicenow", httpOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{RetryCodes: []int{http.StatusTooManyRequests}},
+	}, nil
+}
+
+type record struct {
+	SysID  string `json:"sys_id"`
+	Number string `json:"number"`
+}
+
+type queryResponse struct {
+	Result []record `json:"result"`
+}
+
+// Notify implements the Notifier interface. Records are correlated with the
+// aggregation group through the hash of the group key, so that the same
+// incident is updated until the group is resolved.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, err := notify.ExtractGroupKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger := log.With(n.logger, "group_key", key)
+	correlationID := key.Hash()
+
+	existing, retry, err := n.findRecord(ctx, correlationID)
+	if err != nil {
+		return retry, err
+	}
+
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	var fields map[string]string
+	if types.Alerts(as...).Status() == model.AlertResolved {
+		if existing == nil {
+			level.Debug(logger).Log("msg", "no open incident to resolve")
+			return false, nil
+		}
+		fields = map[string]string{
+			"state":       n.conf.ResolveState,
+			"close_notes": tmpl(n.conf.CloseNotes),
+		}
+		if code := tmpl(n.conf.CloseCode); code != "" {
+			fields["close_code"] = code
+		}
+	} else {
+		fields = n.incidentFields(tmpl, logger)
+	}
+	if err != nil {
+		return false, fmt.Errorf("template: %w", err)
+	}
+
+	if existing != nil {
+		level.Debug(logger).Log("msg", "updating incident", "incident", existing.Number)
+		return n.do(ctx, http.MethodPatch, n.conf.APIURL.JoinPath("api/now/table", n.conf.Table, existing.SysID).String(), fields, nil)
+	}
+
+	fields["correlation_id"] = correlationID
+	fields["correlation_display"] = correlationDisplay
+	var res struct {
+		Result record `json:"result"`
+	}
+	if retry, err := n.do(ctx, http.MethodPost, n.conf.APIURL.JoinPath("api/now/table", n.conf.Table).String(), fields, &res); err != nil {
+		return retry, err
+	}
+	level.Debug(logger).Log("msg", "created incident", "incident", res.Result.Number)
+	return false, nil
+}
+
+// incidentFields returns the fields of a firing incident. Empty fields are
+// left out so that they keep their current or default values.
+func (n *Notifier) incidentFields(tmpl func(string) string, logger log.Logger) map[string]string {
+	fields := make(map[string]string, len(n.conf.Fields)+5)
+	for k, v := range n.conf.Fields {
+		if v = tmpl(v); v != "" {
+			fields[k] = v
+		}
+	}
+
+	shortDescription, truncated := notify.TruncateInRunes(tmpl(n.conf.ShortDescription), maxShortDescriptionLenRunes)
+	if truncated {
+		level.Warn(logger).Log("msg", "Truncated short description", "max_runes", maxShortDescriptionLenRunes)
+	}
+	for k, v := range map[string]string{
+		"short_description": shortDescription,
+		"description":       tmpl(n.conf.Description),
+		"assignment_group":  tmpl(n.conf.AssignmentGroup),
+		"impact":            tmpl(n.conf.Impact),
+		"urgency":           tmpl(n.conf.Urgency),
+	} {
+		if v != "" {
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+// findRecord returns the open record correlated with the given ID, nil if
+// there is none.
+func (n *Notifier) findRecord(ctx context.Context, correlationID string) (*record, bool, error) {
+	u := n.conf.APIURL.JoinPath("api/now/table", n.conf.Table)
+	q := url.Values{}
+	q.Set("sysparm_query", fmt.Sprintf("correlation_id=%s^active=true^state!=%s^ORDERBYDESCsys_created_on", correlationID, n.conf.ResolveState))
+	q.Set("sysparm_fields", "sys_id,number")
+	q.Set("sysparm_limit", "1")
+	u.RawQuery = q.Encode()
+
+	var res queryResponse
+	if retry, err := n.do(ctx, http.MethodGet, u.String(), nil, &res); err != nil {
+		return nil, retry, err
+	}
+	if len(res.Result) == 0 {
+		return nil, false, nil
+	}
+	return &res.Result[0], false, nil
+}
+
+// do sends a request to the Table API and decodes the response into out if
+// it isn't nil.
+func (n *Notifier) do(ctx context.Context, method, u string, in, out interface{}) (bool, error) {
+	var buf bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&buf).Encode(in); err != nil {
+			return false, err
+		}
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	switch method {
+	case http.MethodGet:
+		resp, err = notify.Get(ctx, n.client, u)
+	case http.MethodPatch:
+		resp, err = notify.PatchJSON(ctx, n.client, u, &buf)
+	default:
+		resp, err = notify.PostJSON(ctx, n.client, u, &buf)
+	}
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	if retry, err := n.retrier.Check(resp.StatusCode, resp.Body); err != nil {
+		return retry, err
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return false, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicenow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeTableAPI is a stand-in for the ServiceNow Table API of the incident
+// table.
+type fakeTableAPI struct {
+	mtx     sync.Mutex
+	records []map[string]string
+	updates int
+}
+
+func (f *fakeTableAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/now/table/incident")
+	switch {
+	case path == "" && r.Method == http.MethodGet:
+		res := queryResponse{Result: []record{}}
+		query := r.URL.Query().Get("sysparm_query")
+		for i := len(f.records) - 1; i >= 0; i-- {
+			rec := f.records[i]
+			if strings.HasPrefix(query, "correlation_id="+rec["correlation_id"]+"^") &&
+				!strings.Contains(query, "state!="+rec["state"]+"^") {
+				res.Result = append(res.Result, record{SysID: rec["sys_id"], Number: rec["number"]})
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+	case path == "" && r.Method == http.MethodPost:
+		rec := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&rec); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rec["sys_id"] = fmt.Sprintf("sys%d", len(f.records))
+		rec["number"] = fmt.Sprintf("INC%07d", len(f.records))
+		rec["state"] = "1"
+		f.records = append(f.records, rec)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{"result": rec})
+	case r.Method == http.MethodPatch:
+		fields := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, rec := range f.records {
+			if "/"+rec["sys_id"] == path {
+				for k, v := range fields {
+					rec[k] = v
+				}
+				f.updates++
+				json.NewEncoder(w).Encode(map[string]interface{}{"result": rec})
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestServiceNowRetry(t *testing.T) {
+	notifier, err := New(
+		&config.ServiceNowConfig{
+			HTTPConfig: &commoncfg.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	retryCodes := append(test.DefaultRetryCodes(), http.StatusTooManyRequests)
+	for statusCode, expected := range test.RetryTests(retryCodes) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("retry - error on status %d", statusCode))
+	}
+}
+
+func TestServiceNowIncidentLifecycle(t *testing.T) {
+	api := &fakeTableAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultServiceNowConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.APIURL = &config.URL{URL: u}
+	cfg.AssignmentGroup = `{{ .CommonLabels.team }}`
+	cfg.Impact = `{{ if eq .CommonLabels.severity "critical" }}1{{ else }}3{{ end }}`
+	cfg.Urgency = "2"
+	cfg.CloseCode = "Solved (Permanently)"
+	cfg.Fields = map[string]string{
+		"u_service": `{{ .CommonLabels.service }}`,
+		"u_empty":   `{{ .CommonLabels.missing }}`,
+	}
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "HighLatency",
+				"service":   "checkout",
+				"severity":  "critical",
+				"team":      "payments",
+			},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	}
+
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+	require.Len(t, api.records, 1)
+	rec := api.records[0]
+	require.Equal(t, notify.Key("1").Hash(), rec["correlation_id"])
+	require.Equal(t, "Alertmanager", rec["correlation_display"])
+	require.Equal(t, "payments", rec["assignment_group"])
+	require.Equal(t, "1", rec["impact"])
+	require.Equal(t, "2", rec["urgency"])
+	require.Equal(t, "checkout", rec["u_service"])
+	require.NotContains(t, rec, "u_empty")
+	require.Contains(t, rec["short_description"], "[FIRING:1]")
+	require.Contains(t, rec["description"], "alertname = HighLatency")
+
+	// Re-notifications update the same incident.
+	alert.Labels["severity"] = "warning"
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, api.records, 1)
+	require.Equal(t, 1, api.updates)
+	require.Equal(t, "3", rec["impact"])
+
+	// The resolved group closes the incident.
+	resolved := *alert
+	resolved.EndsAt = time.Now().Add(-time.Minute)
+	_, err = notifier.Notify(ctx, &resolved)
+	require.NoError(t, err)
+	require.Equal(t, "6", rec["state"])
+	require.Equal(t, "Solved (Permanently)", rec["close_code"])
+	require.Contains(t, rec["close_notes"], "[RESOLVED]")
+
+	// Resolving again is a no-op as there is no open incident.
+	_, err = notifier.Notify(ctx, &resolved)
+	require.NoError(t, err)
+	require.Equal(t, 2, api.updates)
+
+	// A new incident is opened when the group fires again.
+	_, err = notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, api.records, 2)
+}
+
+func TestServiceNowTemplatingError(t *testing.T) {
+	api := &fakeTableAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	cfg := config.DefaultServiceNowConfig
+	cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+	cfg.APIURL = &config.URL{URL: u}
+	cfg.Urgency = "{{ "
+	notifier, err := New(&cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := notifier.Notify(ctx, &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "HighLatency"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+	})
+	require.ErrorContains(t, err, "template: :1: unclosed action")
+	require.False(t, retry)
+	require.Empty(t, api.records)
+}
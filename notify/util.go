@@ -57,6 +57,16 @@ func PostJSON(ctx context.Context, client *http.Client, url string, body io.Read
 	return post(ctx, client, url, "application/json", body)
 }
 
+// PutJSON sends a PUT request with JSON payload to the given URL.
+func PutJSON(ctx context.Context, client *http.Client, url string, body io.Reader) (*http.Response, error) {
+	return request(ctx, client, http.MethodPut, url, "application/json", body)
+}
+
+// PatchJSON sends a PATCH request with JSON payload to the given URL.
+func PatchJSON(ctx context.Context, client *http.Client, url string, body io.Reader) (*http.Response, error) {
+	return request(ctx, client, http.MethodPatch, url, "application/json", body)
+}
+
 // PostText sends a POST request with text payload to the given URL.
 func PostText(ctx context.Context, client *http.Client, url string, body io.Reader) (*http.Response, error) {
 	return post(ctx, client, url, "text/plain", body)
@@ -243,7 +253,23 @@ func (r *Retrier) Check(statusCode int, body io.Reader) (bool, error) {
 	if details != "" {
 		s = fmt.Sprintf("%s: %s", s, details)
 	}
-	return retry, errors.New(s)
+	return retry, &ErrorWithStatusCode{Err: errors.New(s), StatusCode: statusCode}
+}
+
+// ErrorWithStatusCode is an error caused by an unexpected HTTP status code. It
+// allows RetryStage to override whether the request is retried.
+type ErrorWithStatusCode struct {
+	Err error
+
+	StatusCode int
+}
+
+func (e *ErrorWithStatusCode) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrorWithStatusCode) Unwrap() error {
+	return e.Err
 }
 
 type ErrorWithReason struct {
@@ -263,6 +289,10 @@ func (e *ErrorWithReason) Error() string {
 	return e.Err.Error()
 }
 
+func (e *ErrorWithReason) Unwrap() error {
+	return e.Err
+}
+
 // Reason is the failure reason.
 type Reason int
 
@@ -272,6 +302,7 @@ const (
 	ServerErrorReason
 	ContextCanceledReason
 	ContextDeadlineExceededReason
+	CircuitOpenReason
 )
 
 func (s Reason) String() string {
@@ -286,13 +317,15 @@ func (s Reason) String() string {
 		return "contextCanceled"
 	case ContextDeadlineExceededReason:
 		return "contextDeadlineExceeded"
+	case CircuitOpenReason:
+		return "circuitOpen"
 	default:
 		panic(fmt.Sprintf("unknown Reason: %d", s))
 	}
 }
 
 // possibleFailureReasonCategory is a list of possible failure reason.
-var possibleFailureReasonCategory = []string{DefaultReason.String(), ClientErrorReason.String(), ServerErrorReason.String(), ContextCanceledReason.String(), ContextDeadlineExceededReason.String()}
+var possibleFailureReasonCategory = []string{DefaultReason.String(), ClientErrorReason.String(), ServerErrorReason.String(), ContextCanceledReason.String(), ContextDeadlineExceededReason.String(), CircuitOpenReason.String()}
 
 // GetFailureReasonFromStatusCode returns the reason for the failure based on the status code provided.
 func GetFailureReasonFromStatusCode(statusCode int) Reason {
@@ -0,0 +1,252 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Limits configures the limits enforced by the callback returned by
+// NewLimitsCallback. A value of 0 means no limit.
+type Limits struct {
+	// MaxAlerts is the maximum number of alerts in the store.
+	MaxAlerts int
+	// MaxAlertSizeBytes is the maximum size of a single alert.
+	MaxAlertSizeBytes int
+
+	// Label is the name of the label whose values are limited separately.
+	// Alerts without the label share the empty value.
+	Label model.LabelName
+	// MaxAlertsPerLabelValue is the maximum number of alerts in the store
+	// with the same value of Label.
+	MaxAlertsPerLabelValue int
+	// MaxSizeBytesPerLabelValue is the maximum total size of the alerts in
+	// the store with the same value of Label.
+	MaxSizeBytesPerLabelValue int
+}
+
+type usage struct {
+	alerts int
+	size   int
+}
+
+// reservation holds the usage of an alert before PreStore reserved its new
+// usage.
+type reservation struct {
+	size   int
+	stored bool
+}
+
+type limitsCallback struct {
+	limits Limits
+
+	mtx sync.Mutex
+	// sizes holds the size of every alert in the store.
+	sizes   map[model.Fingerprint]int
+	byValue map[model.LabelValue]*usage
+	// reserved holds the alerts accepted by PreStore which are not stored
+	// yet.
+	reserved map[model.Fingerprint]reservation
+
+	metrics *limitsMetrics
+}
+
+type limitsMetrics struct {
+	limitedTotal *prometheus.CounterVec
+}
+
+const (
+	reasonMaxAlerts                 = "max_alerts"
+	reasonMaxAlertSize              = "max_alert_size"
+	reasonMaxAlertsPerLabelValue    = "max_alerts_per_label_value"
+	reasonMaxSizeBytesPerLabelValue = "max_size_per_label_value"
+)
+
+func newLimitsMetrics(r prometheus.Registerer) *limitsMetrics {
+	m := &limitsMetrics{
+		limitedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_limited_total",
+			Help: "The total number of alerts that were not stored because a limit was reached.",
+		}, []string{"reason"}),
+	}
+	for _, reason := range []string{reasonMaxAlerts, reasonMaxAlertSize, reasonMaxAlertsPerLabelValue, reasonMaxSizeBytesPerLabelValue} {
+		m.limitedTotal.WithLabelValues(reason)
+	}
+	if r != nil {
+		r.MustRegister(m.limitedTotal)
+	}
+	return m
+}
+
+// NewLimitsCallback returns an AlertStoreCallback that rejects alerts which
+// would make the store exceed the given limits. Updates of alerts that are
+// already in the store are only subject to the size limits.
+func NewLimitsCallback(l Limits, r prometheus.Registerer) AlertStoreCallback {
+	return &limitsCallback{
+		limits:   l,
+		sizes:    map[model.Fingerprint]int{},
+		byValue:  map[model.LabelValue]*usage{},
+		reserved: map[model.Fingerprint]reservation{},
+		metrics:  newLimitsMetrics(r),
+	}
+}
+
+// alertSize returns the approximate size of the alert in bytes.
+func alertSize(a *types.Alert) int {
+	size := len(a.GeneratorURL)
+	for ln, lv := range a.Labels {
+		size += len(ln) + len(lv)
+	}
+	for ln, lv := range a.Annotations {
+		size += len(ln) + len(lv)
+	}
+	return size
+}
+
+func (c *limitsCallback) limited(reason string, err error) error {
+	c.metrics.limitedTotal.WithLabelValues(reason).Inc()
+	return err
+}
+
+// PreStore implements the AlertStoreCallback interface. The usage of the
+// alert is reserved under the same lock as the limits are checked so that
+// concurrent puts cannot exceed them. The reservation is released by
+// StoreFailed if the alert cannot be stored.
+func (c *limitsCallback) PreStore(alert *types.Alert, _ bool) error {
+	size := alertSize(alert)
+	if limit := c.limits.MaxAlertSizeBytes; limit > 0 && size > limit {
+		return c.limited(reasonMaxAlertSize, fmt.Errorf("alert %s exceeds the maximum alert size: %d > %d bytes", alert.Name(), size, limit))
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	fp := alert.Fingerprint()
+	oldSize, stored := c.sizes[fp]
+
+	if !stored {
+		if limit := c.limits.MaxAlerts; limit > 0 && len(c.sizes) >= limit {
+			return c.limited(reasonMaxAlerts, fmt.Errorf("alert %s rejected: the maximum number of alerts (%d) is reached", alert.Name(), limit))
+		}
+	}
+
+	var u *usage
+	if c.limits.Label != "" {
+		value := alert.Labels[c.limits.Label]
+		var ok bool
+		u, ok = c.byValue[value]
+		if !ok {
+			u = &usage{}
+		}
+
+		if !stored {
+			if limit := c.limits.MaxAlertsPerLabelValue; limit > 0 && u.alerts >= limit {
+				return c.limited(reasonMaxAlertsPerLabelValue, fmt.Errorf("alert %s rejected: the maximum number of alerts (%d) for %s=%q is reached", alert.Name(), limit, c.limits.Label, value))
+			}
+		}
+		if limit := c.limits.MaxSizeBytesPerLabelValue; limit > 0 && u.size-oldSize+size > limit {
+			return c.limited(reasonMaxSizeBytesPerLabelValue, fmt.Errorf("alert %s rejected: the maximum size of alerts (%d bytes) for %s=%q is reached", alert.Name(), limit, c.limits.Label, value))
+		}
+		c.byValue[value] = u
+	}
+
+	// Keep the first reservation if the same alert is put concurrently,
+	// it holds the usage from before both puts.
+	if _, ok := c.reserved[fp]; !ok {
+		c.reserved[fp] = reservation{size: oldSize, stored: stored}
+	}
+	c.sizes[fp] = size
+	if u != nil {
+		u.size += size - oldSize
+		if !stored {
+			u.alerts++
+		}
+	}
+
+	return nil
+}
+
+// PostStore implements the AlertStoreCallback interface.
+func (c *limitsCallback) PostStore(alert *types.Alert, _ bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.reserved, alert.Fingerprint())
+}
+
+// StoreFailed releases the usage reserved by PreStore for an alert that could
+// not be stored.
+func (c *limitsCallback) StoreFailed(alert *types.Alert) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	fp := alert.Fingerprint()
+	r, ok := c.reserved[fp]
+	if !ok {
+		return
+	}
+	delete(c.reserved, fp)
+
+	size := c.sizes[fp]
+	if r.stored {
+		c.sizes[fp] = r.size
+	} else {
+		delete(c.sizes, fp)
+	}
+
+	if c.limits.Label == "" {
+		return
+	}
+	value := alert.Labels[c.limits.Label]
+	if u, ok := c.byValue[value]; ok {
+		u.size -= size - r.size
+		if !r.stored {
+			u.alerts--
+			if u.alerts <= 0 {
+				delete(c.byValue, value)
+			}
+		}
+	}
+}
+
+// PostDelete implements the AlertStoreCallback interface.
+func (c *limitsCallback) PostDelete(alert *types.Alert) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	fp := alert.Fingerprint()
+	size, stored := c.sizes[fp]
+	if !stored {
+		return
+	}
+	delete(c.sizes, fp)
+
+	if c.limits.Label == "" {
+		return
+	}
+	value := alert.Labels[c.limits.Label]
+	if u, ok := c.byValue[value]; ok {
+		u.size -= size
+		u.alerts--
+		if u.alerts <= 0 {
+			delete(c.byValue, value)
+		}
+	}
+}
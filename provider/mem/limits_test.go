@@ -0,0 +1,223 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newLimitsTestAlert(name, tenant string) *types.Alert {
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": model.LabelValue(name), "tenant": model.LabelValue(tenant)},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+}
+
+func TestLimitsCallback(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		limits   Limits
+		alerts   []*types.Alert
+		rejected []string
+		reason   string
+	}{
+		{
+			name:   "no limits",
+			limits: Limits{},
+			alerts: []*types.Alert{
+				newLimitsTestAlert("a1", "t1"),
+				newLimitsTestAlert("a2", "t1"),
+			},
+		},
+		{
+			name:   "max alerts",
+			limits: Limits{MaxAlerts: 2},
+			alerts: []*types.Alert{
+				newLimitsTestAlert("a1", "t1"),
+				newLimitsTestAlert("a2", "t2"),
+				newLimitsTestAlert("a3", "t3"),
+			},
+			rejected: []string{"a3"},
+			reason:   reasonMaxAlerts,
+		},
+		{
+			name:   "max alert size",
+			limits: Limits{MaxAlertSizeBytes: 30},
+			alerts: []*types.Alert{
+				newLimitsTestAlert("a1", "t1"),
+				newLimitsTestAlert("a2", strings.Repeat("t", 20)),
+			},
+			rejected: []string{"a2"},
+			reason:   reasonMaxAlertSize,
+		},
+		{
+			name:   "max alerts per label value",
+			limits: Limits{Label: "tenant", MaxAlertsPerLabelValue: 1},
+			alerts: []*types.Alert{
+				newLimitsTestAlert("a1", "t1"),
+				newLimitsTestAlert("a2", "t1"),
+				newLimitsTestAlert("a3", "t2"),
+			},
+			rejected: []string{"a2"},
+			reason:   reasonMaxAlertsPerLabelValue,
+		},
+		{
+			name:   "max size per label value",
+			limits: Limits{Label: "tenant", MaxSizeBytesPerLabelValue: 30},
+			alerts: []*types.Alert{
+				newLimitsTestAlert("a1", "t1"),
+				newLimitsTestAlert("a2", "t1"),
+				newLimitsTestAlert("a3", "t2"),
+			},
+			rejected: []string{"a2"},
+			reason:   reasonMaxSizeBytesPerLabelValue,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := NewLimitsCallback(tc.limits, prometheus.NewRegistry())
+			alerts, err := NewAlerts(context.Background(), types.NewMarker(prometheus.NewRegistry()), 30*time.Minute, cb, log.NewNopLogger(), nil)
+			require.NoError(t, err)
+			defer alerts.Close()
+
+			err = alerts.Put(tc.alerts...)
+			if len(tc.rejected) == 0 {
+				require.NoError(t, err)
+			} else {
+				var rejected *types.MultiError
+				require.ErrorAs(t, err, &rejected)
+				require.Equal(t, len(tc.rejected), rejected.Len())
+				m := cb.(*limitsCallback).metrics
+				require.Equal(t, float64(len(tc.rejected)), testutil.ToFloat64(m.limitedTotal.WithLabelValues(tc.reason)))
+			}
+
+			for _, a := range tc.alerts {
+				_, err := alerts.Get(a.Fingerprint())
+				if contains(tc.rejected, a.Name()) {
+					require.Error(t, err, a.Name())
+				} else {
+					require.NoError(t, err, a.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestLimitsCallbackUpdateAndDelete(t *testing.T) {
+	cb := NewLimitsCallback(Limits{MaxAlerts: 1, Label: "tenant", MaxAlertsPerLabelValue: 1}, nil)
+
+	a1 := newLimitsTestAlert("a1", "t1")
+	require.NoError(t, cb.PreStore(a1, false))
+	cb.PostStore(a1, false)
+
+	// Updates of stored alerts are accepted.
+	require.NoError(t, cb.PreStore(a1, true))
+	cb.PostStore(a1, true)
+
+	a2 := newLimitsTestAlert("a2", "t1")
+	require.Error(t, cb.PreStore(a2, false))
+
+	// Deleted alerts free their slot.
+	cb.PostDelete(a1)
+	require.NoError(t, cb.PreStore(a2, false))
+}
+
+func TestLimitsCallbackStoreFailed(t *testing.T) {
+	cb := NewLimitsCallback(Limits{MaxAlerts: 1, Label: "tenant", MaxSizeBytesPerLabelValue: 100}, nil)
+
+	// The slot reserved by an alert which could not be stored is released.
+	a1 := newLimitsTestAlert("a1", "t1")
+	require.NoError(t, cb.PreStore(a1, false))
+	cb.(*limitsCallback).StoreFailed(a1)
+
+	a2 := newLimitsTestAlert("a2", "t1")
+	require.NoError(t, cb.PreStore(a2, false))
+	cb.PostStore(a2, false)
+
+	// A failed update restores the previous size of the alert.
+	big := newLimitsTestAlert("a2", "t1")
+	big.Annotations = model.LabelSet{"summary": model.LabelValue(strings.Repeat("x", 50))}
+	require.NoError(t, cb.PreStore(big, true))
+	cb.(*limitsCallback).StoreFailed(big)
+	require.NoError(t, cb.PreStore(big, true))
+}
+
+func TestLimitsConcurrentPut(t *testing.T) {
+	const limit = 10
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, NewLimitsCallback(Limits{MaxAlerts: limit}, nil), log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			alerts.Put(newLimitsTestAlert(fmt.Sprintf("a%d", i), "t1"))
+		}(i)
+	}
+	wg.Wait()
+
+	n := 0
+	it := alerts.GetPending()
+	for range it.Next() {
+		n++
+	}
+	it.Close()
+	require.Equal(t, limit, n)
+
+	// Alerts accepted by PreStore count against the limit before they are
+	// stored.
+	cb := NewLimitsCallback(Limits{MaxAlerts: limit}, nil)
+	var accepted atomic.Int32
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if cb.PreStore(newLimitsTestAlert(fmt.Sprintf("a%d", i), "t1"), false) == nil {
+				accepted.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, int32(limit), accepted.Load())
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
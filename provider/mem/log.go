@@ -0,0 +1,338 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+// logRecord is a single entry of the alert log. Either Alert is set, in which
+// case it replaces any previous state of the alert, or Deleted holds the
+// fingerprint of an alert that was garbage collected.
+type logRecord struct {
+	Alert   *types.Alert      `json:"alert,omitempty"`
+	Deleted model.Fingerprint `json:"deleted,omitempty"`
+}
+
+// alertLog is an append-only write-ahead log of the changes applied to the
+// alert store. It is compacted periodically by rewriting it from the
+// current content of the store.
+type alertLog struct {
+	mtx      sync.Mutex
+	filename string
+	f        *os.File
+	w        *bufio.Writer
+	size     int64
+	// pending holds the records appended while the log is being rewritten.
+	pending [][]byte
+}
+
+type logMetrics struct {
+	writeErrorsTotal       prometheus.Counter
+	maintenanceTotal       prometheus.Counter
+	maintenanceErrorsTotal prometheus.Counter
+	size                   prometheus.Gauge
+}
+
+func newLogMetrics(r prometheus.Registerer) *logMetrics {
+	m := &logMetrics{
+		writeErrorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_log_write_errors_total",
+			Help: "Number of alert changes that could not be written to the alert log.",
+		}),
+		maintenanceTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_log_maintenance_total",
+			Help: "How many maintenances were executed for the alert log.",
+		}),
+		maintenanceErrorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_log_maintenance_errors_total",
+			Help: "How many maintenances were executed for the alert log that failed.",
+		}),
+		size: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alertmanager_alerts_log_size_bytes",
+			Help: "Size of the alert log in bytes.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(m.writeErrorsTotal, m.maintenanceTotal, m.maintenanceErrorsTotal, m.size)
+	}
+	return m
+}
+
+// append writes the record to the log. Records are flushed to the
+// operating system immediately so that they survive a crash of the process.
+func (l *alertLog) append(rec logRecord) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.f == nil {
+		return errors.New("alert log is closed")
+	}
+	if l.pending != nil {
+		l.pending = append(l.pending, b)
+	}
+	n, err := l.w.Write(b)
+	l.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return l.w.Flush()
+}
+
+// rewrite atomically replaces the log with the given alerts. Records
+// appended while the alerts are written out are added to the new log
+// before it replaces the old one, so that no change is lost.
+func (l *alertLog) rewrite(list func() []*types.Alert) (int64, error) {
+	l.mtx.Lock()
+	l.pending = [][]byte{}
+	l.mtx.Unlock()
+
+	tmpFilename := fmt.Sprintf("%s.%x", l.filename, uint64(rand.Int63()))
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		l.mtx.Lock()
+		l.pending = nil
+		l.mtx.Unlock()
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+
+	var size int64
+	write := func(b []byte) error {
+		n, err := w.Write(b)
+		size += int64(n)
+		return err
+	}
+	err = func() error {
+		for _, a := range list() {
+			b, err := json.Marshal(logRecord{Alert: a})
+			if err != nil {
+				return err
+			}
+			if err := write(append(b, '\n')); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	abort := func(err error) (int64, error) {
+		l.pending = nil
+		f.Close()
+		os.Remove(tmpFilename)
+		return 0, err
+	}
+	if err != nil {
+		return abort(err)
+	}
+	for _, b := range l.pending {
+		if err := write(b); err != nil {
+			return abort(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return abort(err)
+	}
+	if err := f.Sync(); err != nil {
+		return abort(err)
+	}
+	if err := os.Rename(tmpFilename, l.filename); err != nil {
+		return abort(err)
+	}
+
+	if l.f != nil {
+		l.f.Close()
+	}
+	l.f, l.w, l.size, l.pending = f, w, size, nil
+
+	// The rename only survives a crash once the directory is synced.
+	if err := syncDir(filepath.Dir(l.filename)); err != nil {
+		return size, err
+	}
+	return size, nil
+}
+
+// syncDir flushes the entries of the directory to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
+
+// readLog decodes all records of the log at filename and returns the alerts
+// they describe. A corrupted record, typically the result of a crash in the
+// middle of a write, ends the replay.
+func readLog(filename string) (map[model.Fingerprint]*types.Alert, error) {
+	alerts := map[model.Fingerprint]*types.Alert{}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return alerts, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		b, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			if len(b) > 0 {
+				return alerts, errors.New("incomplete record at end of alert log")
+			}
+			return alerts, nil
+		}
+		if err != nil {
+			return alerts, err
+		}
+
+		var rec logRecord
+		if err := json.Unmarshal(b, &rec); err != nil {
+			return alerts, fmt.Errorf("decode record: %w", err)
+		}
+		switch {
+		case rec.Alert != nil:
+			alerts[rec.Alert.Fingerprint()] = rec.Alert
+		case rec.Deleted != 0:
+			delete(alerts, rec.Deleted)
+		}
+	}
+}
+
+// OpenLog restores the alerts recorded in the log at filename into the
+// store and records all subsequent changes to the store in it. It must be
+// called before any consumer subscribes to the alerts.
+func (a *Alerts) OpenLog(filename string) error {
+	alerts, err := readLog(filename)
+	if err != nil {
+		// Keep what could be read, the rest is dropped when the log is
+		// rewritten below.
+		level.Warn(a.logger).Log("msg", "Alert log is corrupted, replaying the readable part only", "file", filename, "err", err)
+	}
+
+	for _, alert := range alerts {
+		if err := a.callback.PreStore(alert, false); err != nil {
+			level.Warn(a.logger).Log("msg", "pre-store callback rejected alert from the alert log", "alert", alert.String(), "err", err)
+			continue
+		}
+		if err := a.alerts.Set(alert); err != nil {
+			level.Error(a.logger).Log("msg", "error on set alert from the alert log", "err", err)
+			a.storeFailed(alert)
+			continue
+		}
+		a.callback.PostStore(alert, false)
+	}
+
+	l := &alertLog{filename: filename}
+	size, err := l.rewrite(a.alerts.List)
+	if err != nil {
+		return fmt.Errorf("rewrite alert log: %w", err)
+	}
+	a.logMetrics.size.Set(float64(size))
+
+	a.log.Store(l)
+
+	level.Info(a.logger).Log("msg", "Restored alerts from the alert log", "file", filename, "alerts", len(alerts))
+	return nil
+}
+
+// record appends a record to the alert log, if there is one.
+func (a *Alerts) record(rec logRecord) {
+	l := a.log.Load()
+	if l == nil {
+		return
+	}
+	if err := l.append(rec); err != nil {
+		a.logMetrics.writeErrorsTotal.Inc()
+		level.Error(a.logger).Log("msg", "error writing to the alert log", "err", err)
+	}
+}
+
+// Maintenance compacts the alert log at the given interval until stopc is
+// closed. A final compaction is done on shutdown.
+func (a *Alerts) Maintenance(interval time.Duration, stopc <-chan struct{}) {
+	if interval == 0 || stopc == nil {
+		level.Error(a.logger).Log("msg", "interval or stop signal are missing - not running maintenance")
+		return
+	}
+
+	l := a.log.Load()
+	if l == nil {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	runMaintenance := func() error {
+		a.logMetrics.maintenanceTotal.Inc()
+		start := time.Now()
+		level.Debug(a.logger).Log("msg", "Running maintenance")
+		size, err := l.rewrite(a.alerts.List)
+		if err != nil {
+			a.logMetrics.maintenanceErrorsTotal.Inc()
+			return err
+		}
+		a.logMetrics.size.Set(float64(size))
+		level.Debug(a.logger).Log("msg", "Maintenance done", "duration", time.Since(start), "size", size)
+		return nil
+	}
+
+Loop:
+	for {
+		select {
+		case <-stopc:
+			break Loop
+		case <-t.C:
+			if err := runMaintenance(); err != nil {
+				level.Error(a.logger).Log("msg", "Running maintenance failed", "err", err)
+			}
+		}
+	}
+
+	if err := runMaintenance(); err != nil {
+		level.Error(a.logger).Log("msg", "Compacting alert log on shutdown failed", "err", err)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/store"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newLogTestAlert(name string) *types.Alert {
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:       model.LabelSet{"alertname": model.LabelValue(name)},
+			Annotations:  model.LabelSet{"foo": "bar"},
+			StartsAt:     now,
+			EndsAt:       now.Add(time.Hour),
+			GeneratorURL: "http://example.com/prometheus",
+		},
+		UpdatedAt: now,
+	}
+}
+
+func newLogTestAlerts(t *testing.T, filename string) *Alerts {
+	t.Helper()
+
+	alerts, err := NewAlerts(context.Background(), types.NewMarker(prometheus.NewRegistry()), 30*time.Minute, noopCallback{}, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	t.Cleanup(alerts.Close)
+	require.NoError(t, alerts.OpenLog(filename))
+
+	return alerts
+}
+
+func TestAlertsLogReplay(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "alerts")
+
+	a1, a2, a3 := newLogTestAlert("a1"), newLogTestAlert("a2"), newLogTestAlert("a3")
+
+	alerts := newLogTestAlerts(t, filename)
+	require.NoError(t, alerts.Put(a1, a2, a3))
+
+	// Update an alert so that the log holds several records for it.
+	a2Updated := *a2
+	a2Updated.Annotations = model.LabelSet{"foo": "baz"}
+	a2Updated.UpdatedAt = a2.UpdatedAt.Add(time.Second)
+	require.NoError(t, alerts.Put(&a2Updated))
+
+	// Deletions through garbage collection are recorded as well.
+	alerts.record(logRecord{Deleted: a3.Fingerprint()})
+
+	restored := newLogTestAlerts(t, filename)
+
+	res, err := restored.Get(a1.Fingerprint())
+	require.NoError(t, err)
+	require.True(t, alertsEqual(a1, res))
+
+	res, err = restored.Get(a2.Fingerprint())
+	require.NoError(t, err)
+	require.True(t, alertsEqual(&a2Updated, res))
+
+	_, err = restored.Get(a3.Fingerprint())
+	require.Equal(t, store.ErrNotFound, err)
+}
+
+func TestAlertsLogGC(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "alerts")
+
+	alerts, err := NewAlerts(context.Background(), types.NewMarker(prometheus.NewRegistry()), 200*time.Millisecond, noopCallback{}, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+	require.NoError(t, alerts.OpenLog(filename))
+
+	resolved := newLogTestAlert("resolved")
+	resolved.EndsAt = resolved.StartsAt.Add(time.Millisecond)
+	require.NoError(t, alerts.Put(resolved))
+
+	require.Eventually(t, func() bool {
+		_, err := alerts.Get(resolved.Fingerprint())
+		return err == store.ErrNotFound
+	}, time.Second, 50*time.Millisecond)
+
+	restored, err := readLog(filename)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+}
+
+func TestAlertsLogCorruptedTail(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "alerts")
+
+	a1 := newLogTestAlert("a1")
+
+	alerts := newLogTestAlerts(t, filename)
+	require.NoError(t, alerts.Put(a1))
+
+	// Simulate a crash in the middle of a write.
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o666)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"alert":{"labels":{"alertname":"a2"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	restored := newLogTestAlerts(t, filename)
+	res, err := restored.Get(a1.Fingerprint())
+	require.NoError(t, err)
+	require.True(t, alertsEqual(a1, res))
+
+	// The corrupted record was dropped when the log got rewritten.
+	entries, err := readLog(filename)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestAlertsLogConcurrentPut(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "alerts")
+	alerts := newLogTestAlerts(t, filename)
+	a1 := newLogTestAlert("a1")
+
+	// The records of concurrent changes of the same alert are appended in
+	// the order in which the changes were applied to the store.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				a := *a1
+				a.UpdatedAt = a1.UpdatedAt.Add(time.Duration(i*50+j) * time.Millisecond)
+				require.NoError(t, alerts.Put(&a))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	stored, err := alerts.Get(a1.Fingerprint())
+	require.NoError(t, err)
+	entries, err := readLog(filename)
+	require.NoError(t, err)
+	require.True(t, stored.UpdatedAt.Equal(entries[a1.Fingerprint()].UpdatedAt), "stored %s, replayed %s", stored.UpdatedAt, entries[a1.Fingerprint()].UpdatedAt)
+}
+
+func TestAlertsLogMaintenance(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "alerts")
+
+	a1 := newLogTestAlert("a1")
+
+	alerts := newLogTestAlerts(t, filename)
+	for i := 0; i < 10; i++ {
+		a := *a1
+		a.UpdatedAt = a1.UpdatedAt.Add(time.Duration(i) * time.Second)
+		require.NoError(t, alerts.Put(&a))
+	}
+
+	fi, err := os.Stat(filename)
+	require.NoError(t, err)
+	before := fi.Size()
+
+	stopc := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		alerts.Maintenance(time.Hour, stopc)
+		close(done)
+	}()
+	close(stopc)
+	<-done
+
+	// Only the latest state of the alert is kept after the compaction.
+	fi, err = os.Stat(filename)
+	require.NoError(t, err)
+	require.Less(t, fi.Size(), before)
+
+	entries, err := readLog(filename)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, a1.UpdatedAt.Add(9*time.Second).Unix(), entries[a1.Fingerprint()].UpdatedAt.Unix())
+}
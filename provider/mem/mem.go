@@ -16,6 +16,7 @@ package mem
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kit/log"
@@ -43,6 +44,11 @@ type Alerts struct {
 
 	callback AlertStoreCallback
 
+	// log is set once by OpenLog. It is read without holding mtx, as the
+	// changes of the store are recorded with the lock of the store held.
+	log        atomic.Pointer[alertLog]
+	logMetrics *logMetrics
+
 	logger log.Logger
 }
 
@@ -60,6 +66,12 @@ type AlertStoreCallback interface {
 	PostDelete(alert *types.Alert)
 }
 
+// storeFailedCallback is implemented by the AlertStoreCallbacks which must be
+// told that an alert accepted by PreStore could not be stored.
+type storeFailedCallback interface {
+	StoreFailed(alert *types.Alert)
+}
+
 type listeningAlerts struct {
 	alerts chan *types.Alert
 	done   chan struct{}
@@ -100,13 +112,15 @@ func NewAlerts(ctx context.Context, m types.Marker, intervalGC time.Duration, al
 		logger:    log.With(l, "component", "provider"),
 		callback:  alertCallback,
 	}
+	a.logMetrics = newLogMetrics(r)
 	a.alerts.SetGCCallback(func(alerts []*types.Alert) {
 		for _, alert := range alerts {
-			// As we don't persist alerts, we no longer consider them after
-			// they are resolved. Alerts waiting for resolved notifications are
-			// held in memory in aggregation groups redundantly.
+			// We no longer consider alerts after they are resolved. Alerts
+			// waiting for resolved notifications are held in memory in
+			// aggregation groups redundantly.
 			m.Delete(alert.Fingerprint())
 			a.callback.PostDelete(alert)
+			a.record(logRecord{Deleted: alert.Fingerprint()})
 		}
 
 		a.mtx.Lock()
@@ -196,8 +210,10 @@ func (a *Alerts) Get(fp model.Fingerprint) (*types.Alert, error) {
 	return a.alerts.Get(fp)
 }
 
-// Put adds the given alert to the set.
+// Put adds the given alert to the set. Alerts rejected by the store callback
+// are skipped and the rejection errors are returned as a *types.MultiError.
 func (a *Alerts) Put(alerts ...*types.Alert) error {
+	rejected := &types.MultiError{}
 	for _, alert := range alerts {
 		fp := alert.Fingerprint()
 
@@ -217,11 +233,16 @@ func (a *Alerts) Put(alerts ...*types.Alert) error {
 
 		if err := a.callback.PreStore(alert, existing); err != nil {
 			level.Error(a.logger).Log("msg", "pre-store callback returned error on set alert", "err", err)
+			rejected.Add(err)
 			continue
 		}
 
-		if err := a.alerts.Set(alert); err != nil {
+		// The alert is recorded in the same critical section as it is
+		// stored, so that the records of concurrent changes are appended in
+		// the order in which they were applied.
+		if err := a.alerts.SetWithCallback(alert, func() { a.record(logRecord{Alert: alert}) }); err != nil {
 			level.Error(a.logger).Log("msg", "error on set alert", "err", err)
+			a.storeFailed(alert)
 			continue
 		}
 
@@ -237,6 +258,9 @@ func (a *Alerts) Put(alerts ...*types.Alert) error {
 		a.mtx.Unlock()
 	}
 
+	if rejected.Len() > 0 {
+		return rejected
+	}
 	return nil
 }
 
@@ -259,6 +283,58 @@ func (a *Alerts) count(state types.AlertState) int {
 	return count
 }
 
+// storeFailed releases what the callback reserved for an alert that could not
+// be stored.
+func (a *Alerts) storeFailed(alert *types.Alert) {
+	if c, ok := a.callback.(storeFailedCallback); ok {
+		c.StoreFailed(alert)
+	}
+}
+
+type multiCallback []AlertStoreCallback
+
+// NewMultiCallback returns an AlertStoreCallback which calls the given
+// callbacks in order. If one of them rejects an alert in PreStore, the
+// callbacks which accepted it before are told that it was not stored.
+func NewMultiCallback(callbacks ...AlertStoreCallback) AlertStoreCallback {
+	return multiCallback(callbacks)
+}
+
+// PreStore implements the AlertStoreCallback interface.
+func (m multiCallback) PreStore(alert *types.Alert, existing bool) error {
+	for i, c := range m {
+		if err := c.PreStore(alert, existing); err != nil {
+			m[:i].StoreFailed(alert)
+			return err
+		}
+	}
+	return nil
+}
+
+// PostStore implements the AlertStoreCallback interface.
+func (m multiCallback) PostStore(alert *types.Alert, existing bool) {
+	for _, c := range m {
+		c.PostStore(alert, existing)
+	}
+}
+
+// PostDelete implements the AlertStoreCallback interface.
+func (m multiCallback) PostDelete(alert *types.Alert) {
+	for _, c := range m {
+		c.PostDelete(alert)
+	}
+}
+
+// StoreFailed releases what the callbacks reserved for an alert that could
+// not be stored.
+func (m multiCallback) StoreFailed(alert *types.Alert) {
+	for _, c := range m {
+		if c, ok := c.(storeFailedCallback); ok {
+			c.StoreFailed(alert)
+		}
+	}
+}
+
 type noopCallback struct{}
 
 func (n noopCallback) PreStore(_ *types.Alert, _ bool) error { return nil }
@@ -351,10 +351,10 @@ func TestAlertsStoreCallback(t *testing.T) {
 	}
 
 	err = alerts.Put(&alert1Mod, alert4)
-	// Verify that we failed to put new alert into store (not reported via error, only checked using Load)
-	if err != nil {
-		t.Fatalf("unexpected error %v", err)
-	}
+	// Verify that we failed to put new alert into store.
+	var rejected *types.MultiError
+	require.ErrorAs(t, err, &rejected)
+	require.Equal(t, []error{errTooManyAlerts}, rejected.Errors())
 
 	if num := cb.alerts.Load(); num != 3 {
 		t.Fatalf("unexpected number of alerts in the store, expected %v, got %v", 3, num)
@@ -383,6 +383,24 @@ func TestAlertsStoreCallback(t *testing.T) {
 	}
 }
 
+func TestAlertsMultiCallback(t *testing.T) {
+	limits := NewLimitsCallback(Limits{MaxAlerts: 1}, nil)
+	cb := &limitCountCallback{limit: 1}
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, NewMultiCallback(limits, cb), log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	// The alert rejected by the second callback is released from the first
+	// one, which has room for the next alert.
+	cb.limit = 0
+	require.Error(t, alerts.Put(alert1))
+	cb.limit = 1
+	require.NoError(t, alerts.Put(alert2))
+	require.Equal(t, int32(1), cb.alerts.Load())
+}
+
 func TestAlerts_Count(t *testing.T) {
 	marker := types.NewMarker(prometheus.NewRegistry())
 	alerts, err := NewAlerts(context.Background(), marker, 200*time.Millisecond, nil, log.NewNopLogger(), nil)
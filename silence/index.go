@@ -0,0 +1,122 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"github.com/prometheus/common/model"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+)
+
+type labelPair struct {
+	name, value string
+}
+
+// matcherIndex is an inverted index over the equality matchers of silences.
+// As all matchers of a silence have to match a label set, each silence is
+// indexed by a single one of its equality matchers. Silences without equality
+// matchers can match any label set and are always candidates.
+//
+// The index is used to find the candidate silences matching a label set, the
+// candidates still have to be checked against all their matchers.
+type matcherIndex struct {
+	// equal maps label names and values to the IDs of the silences indexed
+	// by an equality matcher on them.
+	equal map[string]map[string]map[string]struct{}
+	// unindexed holds the IDs of the silences without equality matchers.
+	unindexed map[string]struct{}
+	// keys holds the label pair each indexed silence is indexed by.
+	keys map[string]labelPair
+}
+
+func newMatcherIndex(st state) *matcherIndex {
+	idx := &matcherIndex{
+		equal:     map[string]map[string]map[string]struct{}{},
+		unindexed: map[string]struct{}{},
+		keys:      map[string]labelPair{},
+	}
+	for _, e := range st {
+		idx.add(e.Silence)
+	}
+	return idx
+}
+
+// add indexes the silence, replacing any previous entry for its ID. It is a
+// no-op on a nil index.
+func (idx *matcherIndex) add(sil *pb.Silence) {
+	if idx == nil {
+		return
+	}
+	idx.delete(sil.Id)
+
+	for _, m := range sil.Matchers {
+		if m.Type != pb.Matcher_EQUAL {
+			continue
+		}
+		k := labelPair{name: m.Name, value: m.Pattern}
+		values, ok := idx.equal[k.name]
+		if !ok {
+			values = map[string]map[string]struct{}{}
+			idx.equal[k.name] = values
+		}
+		ids, ok := values[k.value]
+		if !ok {
+			ids = map[string]struct{}{}
+			values[k.value] = ids
+		}
+		ids[sil.Id] = struct{}{}
+		idx.keys[sil.Id] = k
+		return
+	}
+	idx.unindexed[sil.Id] = struct{}{}
+}
+
+// delete removes the silence with the given ID from the index. It is a no-op
+// on a nil index.
+func (idx *matcherIndex) delete(id string) {
+	if idx == nil {
+		return
+	}
+	delete(idx.unindexed, id)
+
+	k, ok := idx.keys[id]
+	if !ok {
+		return
+	}
+	delete(idx.keys, id)
+	values := idx.equal[k.name]
+	delete(values[k.value], id)
+	if len(values[k.value]) == 0 {
+		delete(values, k.value)
+	}
+	if len(values) == 0 {
+		delete(idx.equal, k.name)
+	}
+}
+
+// candidates returns the IDs of the silences which may match the label set.
+func (idx *matcherIndex) candidates(lset model.LabelSet) []string {
+	res := make([]string, 0, len(idx.unindexed))
+	for id := range idx.unindexed {
+		res = append(res, id)
+	}
+	// A missing label has the empty value, which equality matchers on the
+	// empty value match.
+	for name, values := range idx.equal {
+		for id := range values[string(lset[model.LabelName(name)])] {
+			res = append(res, id)
+		}
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silence
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestMatcherIndex(t *testing.T) {
+	idx := newMatcherIndex(nil)
+
+	idx.add(&pb.Silence{Id: "1", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_REGEXP, Name: "job", Pattern: "api|web"},
+		{Type: pb.Matcher_EQUAL, Name: "team", Pattern: "db"},
+	}})
+	idx.add(&pb.Silence{Id: "2", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_NOT_EQUAL, Name: "team", Pattern: "db"},
+	}})
+	idx.add(&pb.Silence{Id: "3", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_EQUAL, Name: "env", Pattern: ""},
+	}})
+
+	require.ElementsMatch(t, []string{"1", "2", "3"}, idx.candidates(model.LabelSet{"team": "db"}))
+	require.ElementsMatch(t, []string{"2"}, idx.candidates(model.LabelSet{"team": "web", "env": "prod"}))
+
+	// Replacing a silence removes its previous entry.
+	idx.add(&pb.Silence{Id: "1", Matchers: []*pb.Matcher{
+		{Type: pb.Matcher_EQUAL, Name: "team", Pattern: "web"},
+	}})
+	require.ElementsMatch(t, []string{"2", "3"}, idx.candidates(model.LabelSet{"team": "db"}))
+	require.ElementsMatch(t, []string{"1", "2", "3"}, idx.candidates(model.LabelSet{"team": "web"}))
+
+	idx.delete("1")
+	idx.delete("2")
+	idx.delete("3")
+	require.Empty(t, idx.candidates(model.LabelSet{"team": "web"}))
+	require.Empty(t, idx.equal)
+	require.Empty(t, idx.keys)
+
+	var nilIdx *matcherIndex
+	nilIdx.add(&pb.Silence{Id: "1"})
+	nilIdx.delete("1")
+}
+
+func TestSilencesQueryMatchesIndex(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	s.clock = clock
+	now := s.nowUTC()
+
+	for _, ms := range [][]*pb.Matcher{
+		{{Type: pb.Matcher_EQUAL, Name: "team", Pattern: "db"}},
+		{{Type: pb.Matcher_EQUAL, Name: "team", Pattern: "web"}},
+		{{Type: pb.Matcher_REGEXP, Name: "team", Pattern: "d."}},
+		{{Type: pb.Matcher_NOT_EQUAL, Name: "team", Pattern: "web"}},
+		{{Type: pb.Matcher_EQUAL, Name: "team", Pattern: "db"}, {Type: pb.Matcher_EQUAL, Name: "env", Pattern: ""}},
+	} {
+		_, err := s.Set(&pb.Silence{Matchers: ms, StartsAt: now, EndsAt: now.Add(time.Hour)})
+		require.NoError(t, err)
+	}
+
+	// The state of a peer is indexed as well.
+	peer, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	peer.clock = clock
+	b, err := s.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, peer.Merge(b))
+
+	// So is a loaded snapshot.
+	var buf bytes.Buffer
+	_, err = s.Snapshot(&buf)
+	require.NoError(t, err)
+	loaded, err := New(Options{SnapshotReader: &buf})
+	require.NoError(t, err)
+	loaded.clock = clock
+
+	// The index yields the same results as scanning all silences.
+	for _, lset := range []model.LabelSet{
+		{"team": "db"},
+		{"team": "db", "env": "prod"},
+		{"team": "web"},
+		{"team": "dc"},
+		{},
+	} {
+		scanned := 0
+		for _, e := range s.st {
+			m, err := s.mc.Get(e.Silence)
+			require.NoError(t, err)
+			if m.Matches(lset) {
+				scanned++
+			}
+		}
+		for _, silences := range []*Silences{s, peer, loaded} {
+			sils, _, err := silences.Query(QState(types.SilenceStateActive), QMatches(lset))
+			require.NoError(t, err)
+			require.Len(t, sils, scanned, lset.String())
+		}
+	}
+
+	// Garbage collected silences are removed from the index.
+	clock.Add(3 * time.Hour)
+	_, err = s.GC()
+	require.NoError(t, err)
+	require.Empty(t, s.idx.keys)
+	require.Empty(t, s.idx.unindexed)
+}
@@ -24,6 +24,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -40,6 +41,7 @@ import (
 	"github.com/prometheus/alertmanager/matchers/compat"
 	"github.com/prometheus/alertmanager/pkg/labels"
 	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/timeinterval"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -95,20 +97,55 @@ func (c matcherCache) add(s *pb.Silence) (labels.Matchers, error) {
 // Silencer binds together a Marker and a Silences to implement the Muter
 // interface.
 type Silencer struct {
-	silences *Silences
-	marker   types.Marker
-	logger   log.Logger
+	silences   *Silences
+	marker     types.Marker
+	intervener *timeinterval.Intervener
+	logger     log.Logger
 }
 
-// NewSilencer returns a new Silencer.
-func NewSilencer(s *Silences, m types.Marker, l log.Logger) *Silencer {
+// NewSilencer returns a new Silencer. The intervener resolves the time
+// intervals of recurring silences, if nil they never mute alerts.
+func NewSilencer(s *Silences, m types.Marker, ti *timeinterval.Intervener, l log.Logger) *Silencer {
 	return &Silencer{
-		silences: s,
-		marker:   m,
-		logger:   l,
+		silences:   s,
+		marker:     m,
+		intervener: ti,
+		logger:     l,
 	}
 }
 
+// inTimeIntervals returns whether now is in one of the time intervals of the
+// silence, or true if it has none.
+func (s *Silencer) inTimeIntervals(sil *pb.Silence, now time.Time) bool {
+	in, err := inTimeIntervals(sil, s.intervener, now)
+	if err != nil {
+		level.Debug(s.logger).Log("msg", "Failed to check the time intervals of silence", "id", sil.Id, "err", err)
+		return false
+	}
+	return in
+}
+
+func inTimeIntervals(sil *pb.Silence, ti *timeinterval.Intervener, now time.Time) (bool, error) {
+	if len(sil.TimeIntervals) == 0 {
+		return true, nil
+	}
+	if ti == nil {
+		return false, nil
+	}
+	return ti.Mutes(sil.TimeIntervals, now)
+}
+
+// Mutes returns whether the silence mutes the alerts it matches at the given
+// time like the Silencer does: it is active and, if it has time intervals,
+// one of them contains the time. The intervener resolves the time intervals,
+// if nil recurring silences never mute.
+func Mutes(sil *pb.Silence, ti *timeinterval.Intervener, now time.Time) (bool, error) {
+	if getState(sil, now) != types.SilenceStateActive {
+		return false, nil
+	}
+	return inTimeIntervals(sil, ti, now)
+}
+
 // Mutes implements the Muter interface.
 func (s *Silencer) Mutes(lset model.LabelSet) bool {
 	fp := lset.Fingerprint()
@@ -166,6 +203,12 @@ func (s *Silencer) Mutes(lset model.LabelSet) bool {
 		case types.SilenceStatePending:
 			pendingIDs = append(pendingIDs, sil.Id)
 		case types.SilenceStateActive:
+			// Recurring silences outside of their time intervals are
+			// pending until the next one starts.
+			if !s.inTimeIntervals(sil, now) {
+				pendingIDs = append(pendingIDs, sil.Id)
+				continue
+			}
 			activeIDs = append(activeIDs, sil.Id)
 		default:
 			// Do nothing, silence has expired in the meantime.
@@ -198,7 +241,18 @@ type Silences struct {
 	st        state
 	version   int // Increments whenever silences are added.
 	broadcast func([]byte)
-	mc        matcherCache
+	// onChange is called with each silence created, updated or expired
+	// locally or by a peer.
+	onChange func(*pb.Silence)
+	// expiredUntil is the time up to which the silences ending on their own
+	// were passed to onChange, and changed is signaled when the state changes
+	// so that the next end time is looked up again.
+	expiredUntil time.Time
+	changed      chan struct{}
+	mc           matcherCache
+	// idx indexes the silences of st by their equality matchers. If nil,
+	// queries scan all silences.
+	idx *matcherIndex
 }
 
 // MaintenanceFunc represents the function to run as part of the periodic maintenance for silences.
@@ -339,8 +393,12 @@ func New(o Options) (*Silences, error) {
 		logger:    log.NewNopLogger(),
 		retention: o.Retention,
 		broadcast: func([]byte) {},
+		onChange:  func(*pb.Silence) {},
+		changed:   make(chan struct{}, 1),
 		st:        state{},
+		idx:       newMatcherIndex(nil),
 	}
+	s.expiredUntil = s.nowUTC()
 	s.metrics = newMetrics(o.Metrics, s)
 
 	if o.Logger != nil {
@@ -372,7 +430,8 @@ func (s *Silences) nowUTC() time.Time {
 }
 
 // Maintenance garbage collects the silence state at the given interval. If the snapshot
-// file is set, a snapshot is written to it afterwards.
+// file is set, a snapshot is written to it afterwards. In between, the silences
+// ending on their own are passed to the change callback as their end time passes.
 // Terminates on receiving from stopc.
 // If not nil, the last argument is an override for what to do as part of the maintenance - for advanced usage.
 func (s *Silences) Maintenance(interval time.Duration, snapf string, stopc <-chan struct{}, override MaintenanceFunc) {
@@ -383,6 +442,16 @@ func (s *Silences) Maintenance(interval time.Duration, snapf string, stopc <-cha
 	t := s.clock.Ticker(interval)
 	defer t.Stop()
 
+	untilExpiry := func() time.Duration {
+		next := s.reportExpired()
+		if next.IsZero() {
+			return interval
+		}
+		return next.Sub(s.nowUTC())
+	}
+	expiry := s.clock.Timer(untilExpiry())
+	defer expiry.Stop()
+
 	var doMaintenance MaintenanceFunc
 	doMaintenance = func() (int64, error) {
 		var size int64
@@ -431,6 +500,16 @@ Loop:
 			if err := runMaintenance(doMaintenance); err != nil {
 				level.Info(s.logger).Log("msg", "Running maintenance failed", "err", err)
 			}
+		case <-expiry.C:
+			expiry.Reset(untilExpiry())
+		case <-s.changed:
+			if !expiry.Stop() {
+				select {
+				case <-expiry.C:
+				default:
+				}
+			}
+			expiry.Reset(untilExpiry())
 		}
 	}
 
@@ -443,6 +522,42 @@ Loop:
 	}
 }
 
+// reportExpired passes the silences which ended on their own since the
+// previous call to the change callback. It returns the end time of the next
+// silence to end, or the zero time if none.
+func (s *Silences) reportExpired() time.Time {
+	now := s.nowUTC()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var next time.Time
+	for _, sil := range s.st {
+		endsAt := sil.Silence.EndsAt
+		if endsAt.After(now) {
+			if next.IsZero() || endsAt.Before(next) {
+				next = endsAt
+			}
+			continue
+		}
+		// Explicitly expired silences were already reported when they were
+		// updated.
+		if endsAt.After(s.expiredUntil) && sil.Silence.UpdatedAt.Before(endsAt) {
+			s.onChange(sil.Silence)
+		}
+	}
+	s.expiredUntil = now
+	return next
+}
+
+// signalChanged wakes up the maintenance to look up the next silence to end.
+func (s *Silences) signalChanged() {
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
 // GC runs a garbage collection that removes silences that have ended longer
 // than the configured retention time ago.
 func (s *Silences) GC() (int, error) {
@@ -462,6 +577,7 @@ func (s *Silences) GC() (int, error) {
 		if !sil.ExpiresAt.After(now) {
 			delete(s.st, id)
 			delete(s.mc, sil.Silence)
+			s.idx.delete(id)
 			n++
 		}
 	}
@@ -500,10 +616,7 @@ func matchesEmpty(m *pb.Matcher) bool {
 	}
 }
 
-func validateSilence(s *pb.Silence) error {
-	if s.Id == "" {
-		return errors.New("ID missing")
-	}
+func validateMatchers(s *pb.Silence) error {
 	if len(s.Matchers) == 0 {
 		return errors.New("at least one matcher required")
 	}
@@ -518,6 +631,25 @@ func validateSilence(s *pb.Silence) error {
 	if allMatchEmpty {
 		return errors.New("at least one matcher must not match the empty string")
 	}
+	return nil
+}
+
+// Matchers validates the matchers of the silence and returns them compiled.
+// The silence doesn't need to be stored.
+func Matchers(s *pb.Silence) (labels.Matchers, error) {
+	if err := validateMatchers(s); err != nil {
+		return nil, err
+	}
+	return matcherCache{}.add(s)
+}
+
+func validateSilence(s *pb.Silence) error {
+	if s.Id == "" {
+		return errors.New("ID missing")
+	}
+	if err := validateMatchers(s); err != nil {
+		return err
+	}
 	if s.StartsAt.IsZero() {
 		return errors.New("invalid zero start timestamp")
 	}
@@ -530,6 +662,11 @@ func validateSilence(s *pb.Silence) error {
 	if s.UpdatedAt.IsZero() {
 		return errors.New("invalid zero update timestamp")
 	}
+	for _, name := range s.TimeIntervals {
+		if name == "" {
+			return errors.New("empty time interval name")
+		}
+	}
 	return nil
 }
 
@@ -567,6 +704,9 @@ func (s *Silences) setSilence(sil *pb.Silence, now time.Time, skipValidate bool)
 
 	if s.st.merge(msil, now) {
 		s.version++
+		s.idx.add(sil)
+		s.onChange(sil)
+		s.signalChanged()
 	}
 	s.broadcast(b)
 
@@ -585,16 +725,21 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 	if sil.Id != "" && !ok {
 		return "", ErrNotFound
 	}
+	var history []*pb.Revision
 	if ok {
 		if canUpdate(prev, sil, now) {
+			sil.Revisions = appendRevision(prev.Revisions, newRevision(pb.Revision_UPDATE, sil, sil.CreatedBy, now))
 			return sil.Id, s.setSilence(sil, now, false)
 		}
 		if getState(prev, s.nowUTC()) != types.SilenceStateExpired {
 			// We cannot update the silence, expire the old one.
-			if err := s.expire(prev.Id); err != nil {
+			if err := s.expire(prev.Id, sil.CreatedBy); err != nil {
 				return "", fmt.Errorf("expire previous silence: %w", err)
 			}
+			prev, _ = s.getSilence(prev.Id)
 		}
+		// The replacing silence inherits the history of the previous one.
+		history = prev.Revisions
 	}
 	// If we got here it's either a new silence or a replacing one.
 	uid, err := uuid.NewV4()
@@ -606,16 +751,50 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 	if sil.StartsAt.Before(now) {
 		sil.StartsAt = now
 	}
+	sil.Revisions = appendRevision(history, newRevision(pb.Revision_CREATE, sil, sil.CreatedBy, now))
 
 	return sil.Id, s.setSilence(sil, now, false)
 }
 
+// newRevision returns the revision recording the change of the silence to its
+// current state.
+func newRevision(action pb.Revision_Action, sil *pb.Silence, author string, now time.Time) *pb.Revision {
+	return &pb.Revision{
+		Action:    action,
+		SilenceId: sil.Id,
+		Author:    author,
+		Timestamp: now,
+		Matchers:  sil.Matchers,
+		StartsAt:  sil.StartsAt,
+		EndsAt:    sil.EndsAt,
+		Comment:   sil.Comment,
+	}
+}
+
+// maxRevisions is the maximum number of revisions kept for a silence, as
+// they are copied into every update and gossiped with the silence.
+const maxRevisions = 50
+
+// appendRevision returns a new slice holding the revisions followed by r,
+// dropping the oldest ones beyond maxRevisions. The given revisions may be
+// shared with stored silences and are never modified.
+func appendRevision(revisions []*pb.Revision, r *pb.Revision) []*pb.Revision {
+	if len(revisions) >= maxRevisions {
+		revisions = revisions[len(revisions)-maxRevisions+1:]
+	}
+	res := make([]*pb.Revision, 0, len(revisions)+1)
+	return append(append(res, revisions...), r)
+}
+
 // canUpdate returns true if silence a can be updated to b without
 // affecting the historic view of silencing.
 func canUpdate(a, b *pb.Silence, now time.Time) bool {
 	if !reflect.DeepEqual(a.Matchers, b.Matchers) {
 		return false
 	}
+	if !slices.Equal(a.TimeIntervals, b.TimeIntervals) {
+		return false
+	}
 	// Allowed timestamp modifications depend on the current time.
 	switch st := getState(a, now); st {
 	case types.SilenceStateActive:
@@ -639,15 +818,22 @@ func canUpdate(a, b *pb.Silence, now time.Time) bool {
 
 // Expire the silence with the given ID immediately.
 func (s *Silences) Expire(id string) error {
+	return s.ExpireWithAuthor(id, "")
+}
+
+// ExpireWithAuthor expires the silence with the given ID immediately, the
+// author is recorded in the revisions of the silence.
+func (s *Silences) ExpireWithAuthor(id, author string) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
-	return s.expire(id)
+	return s.expire(id, author)
 }
 
-// Expire the silence with the given ID immediately.
+// Expire the silence with the given ID immediately, the author is recorded in
+// the revisions of the silence.
 // It is idempotent, nil is returned if the silence already expired before it is GC'd.
 // If the silence is not found an error is returned.
-func (s *Silences) expire(id string) error {
+func (s *Silences) expire(id, author string) error {
 	sil, ok := s.getSilence(id)
 	if !ok {
 		return ErrNotFound
@@ -665,6 +851,7 @@ func (s *Silences) expire(id string) error {
 		sil.StartsAt = now
 		sil.EndsAt = now
 	}
+	sil.Revisions = appendRevision(sil.Revisions, newRevision(pb.Revision_EXPIRE, sil, author, now))
 
 	// Skip validation of the silence when expiring it. Without this, silences created
 	// with valid UTF-8 matchers cannot be expired when Alertmanager is run in classic mode.
@@ -677,6 +864,9 @@ type QueryParam func(*query) error
 type query struct {
 	ids     []string
 	filters []silenceFilter
+	// matches restricts the silences to the candidates of the matcher index
+	// for the label set.
+	matches model.LabelSet
 }
 
 // silenceFilter is a function that returns true if a silence
@@ -702,6 +892,7 @@ func QMatches(set model.LabelSet) QueryParam {
 			return m.Matches(set), nil
 		}
 		q.filters = append(q.filters, f)
+		q.matches = set
 		return nil
 	}
 }
@@ -793,13 +984,20 @@ func (s *Silences) query(q *query, now time.Time) ([]*pb.Silence, int, error) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	if q.ids != nil {
+	switch {
+	case q.ids != nil:
 		for _, id := range q.ids {
 			if s, ok := s.st[id]; ok {
 				res = append(res, s.Silence)
 			}
 		}
-	} else {
+	case q.matches != nil && s.idx != nil:
+		for _, id := range s.idx.candidates(q.matches) {
+			if s, ok := s.st[id]; ok {
+				res = append(res, s.Silence)
+			}
+		}
+	default:
 		for _, sil := range s.st {
 			res = append(res, sil.Silence)
 		}
@@ -844,6 +1042,7 @@ func (s *Silences) loadSnapshot(r io.Reader) error {
 	}
 	s.mtx.Lock()
 	s.st = st
+	s.idx = newMatcherIndex(st)
 	s.version++
 	s.mtx.Unlock()
 
@@ -889,6 +1088,9 @@ func (s *Silences) Merge(b []byte) error {
 	for _, e := range st {
 		if merged := s.st.merge(e, now); merged {
 			s.version++
+			s.idx.add(e.Silence)
+			s.onChange(e.Silence)
+			s.signalChanged()
 			if !cluster.OversizedMessage(b) {
 				// If this is the first we've seen the message and it's
 				// not oversized, gossip it to other nodes. We don't
@@ -911,6 +1113,16 @@ func (s *Silences) SetBroadcast(f func([]byte)) {
 	s.mtx.Unlock()
 }
 
+// SetChangeCallback sets the function called with each silence created,
+// updated or expired, either locally or by a peer. Silences which expire when
+// their end time passes are reported by the maintenance at that time. It must
+// not block.
+func (s *Silences) SetChangeCallback(f func(*pb.Silence)) {
+	s.mtx.Lock()
+	s.onChange = f
+	s.mtx.Unlock()
+}
+
 type state map[string]*pb.MeshSilence
 
 func (s state) merge(e *pb.MeshSilence, now time.Time) bool {
@@ -35,6 +35,7 @@ import (
 	"github.com/prometheus/alertmanager/featurecontrol"
 	"github.com/prometheus/alertmanager/matchers/compat"
 	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/timeinterval"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -91,7 +92,7 @@ func TestSilencesGC(t *testing.T) {
 	now := s.nowUTC()
 
 	newSilence := func(exp time.Time) *pb.MeshSilence {
-		return &pb.MeshSilence{ExpiresAt: exp}
+		return &pb.MeshSilence{Silence: &pb.Silence{}, ExpiresAt: exp}
 	}
 	s.st = state{
 		"1": newSilence(now),
@@ -325,6 +326,17 @@ func TestSilenceSet(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, "", id1)
 
+	revision := func(action pb.Revision_Action, id, pattern string, ts, startsAt, endsAt time.Time) *pb.Revision {
+		return &pb.Revision{
+			Action:    action,
+			SilenceId: id,
+			Timestamp: ts,
+			Matchers:  []*pb.Matcher{{Name: "a", Pattern: pattern}},
+			StartsAt:  startsAt,
+			EndsAt:    endsAt,
+		}
+	}
+
 	want := state{
 		id1: &pb.MeshSilence{
 			Silence: &pb.Silence{
@@ -333,6 +345,9 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start1.Add(2 * time.Minute),
 				EndsAt:    start1.Add(5 * time.Minute),
 				UpdatedAt: start1,
+				Revisions: []*pb.Revision{
+					revision(pb.Revision_CREATE, id1, "b", start1, start1.Add(2*time.Minute), start1.Add(5*time.Minute)),
+				},
 			},
 			ExpiresAt: start1.Add(5*time.Minute + s.retention),
 		},
@@ -360,6 +375,9 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start2,
 				EndsAt:    start2.Add(1 * time.Minute),
 				UpdatedAt: start2,
+				Revisions: []*pb.Revision{
+					revision(pb.Revision_CREATE, id2, "b", start2, start2, start2.Add(time.Minute)),
+				},
 			},
 			ExpiresAt: start2.Add(1*time.Minute + s.retention),
 		},
@@ -386,6 +404,10 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start2,
 				EndsAt:    start3.Add(100 * time.Minute),
 				UpdatedAt: start3,
+				Revisions: []*pb.Revision{
+					revision(pb.Revision_CREATE, id2, "b", start2, start2, start2.Add(time.Minute)),
+					revision(pb.Revision_UPDATE, id2, "b", start3, start2, start3.Add(100*time.Minute)),
+				},
 			},
 			ExpiresAt: start3.Add(100*time.Minute + s.retention),
 		},
@@ -404,6 +426,12 @@ func TestSilenceSet(t *testing.T) {
 	// This new silence gets a new id.
 	require.NotEqual(t, id2, id4)
 
+	// The new silence inherits the revisions of the expired one.
+	history := []*pb.Revision{
+		revision(pb.Revision_CREATE, id2, "b", start2, start2, start2.Add(time.Minute)),
+		revision(pb.Revision_UPDATE, id2, "b", start3, start2, start3.Add(100*time.Minute)),
+		revision(pb.Revision_EXPIRE, id2, "b", start4, start2, start4),
+	}
 	want = state{
 		id1: want[id1],
 		id2: &pb.MeshSilence{
@@ -413,6 +441,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start2,
 				EndsAt:    start4, // Expired
 				UpdatedAt: start4,
+				Revisions: history,
 			},
 			ExpiresAt: start4.Add(s.retention),
 		},
@@ -423,6 +452,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start4,
 				EndsAt:    start3.Add(100 * time.Minute),
 				UpdatedAt: start4,
+				Revisions: append(history, revision(pb.Revision_CREATE, id4, "c", start4, start4, start3.Add(100*time.Minute))),
 			},
 			ExpiresAt: start3.Add(100*time.Minute + s.retention),
 		},
@@ -452,6 +482,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  start5, // New silences have their start time set to "now" when created.
 				EndsAt:    start1.Add(5 * time.Minute),
 				UpdatedAt: start5,
+				Revisions: append(history, revision(pb.Revision_CREATE, id5, "b", start5, start5, start1.Add(5*time.Minute))),
 			},
 			ExpiresAt: start1.Add(5*time.Minute + s.retention),
 		},
@@ -503,6 +534,24 @@ func TestSetActiveSilence(t *testing.T) {
 				StartsAt:  newStartsAt,
 				EndsAt:    newEndsAt,
 				UpdatedAt: now,
+				Revisions: []*pb.Revision{
+					{
+						Action:    pb.Revision_CREATE,
+						SilenceId: id1,
+						Timestamp: now.Add(-time.Minute),
+						Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+						StartsAt:  now.Add(-time.Minute),
+						EndsAt:    endsAt,
+					},
+					{
+						Action:    pb.Revision_UPDATE,
+						SilenceId: id1,
+						Timestamp: now,
+						Matchers:  []*pb.Matcher{{Name: "a", Pattern: "b"}},
+						StartsAt:  newStartsAt,
+						EndsAt:    newEndsAt,
+					},
+				},
 			},
 			ExpiresAt: newEndsAt.Add(s.retention),
 		},
@@ -860,6 +909,21 @@ func TestSilenceCanUpdate(t *testing.T) {
 			},
 			ok: true,
 		},
+		// Changed time intervals.
+		{
+			a: &pb.Silence{
+				StartsAt:      now.Add(-time.Hour),
+				EndsAt:        now.Add(2 * time.Hour),
+				UpdatedAt:     now.Add(-time.Hour),
+				TimeIntervals: []string{"weekends"},
+			},
+			b: &pb.Silence{
+				StartsAt:      now.Add(-time.Hour),
+				EndsAt:        now.Add(3 * time.Hour),
+				TimeIntervals: []string{"weekdays"},
+			},
+			ok: false,
+		},
 	}
 	for _, c := range cases {
 		ok := canUpdate(c.a, c.b, now)
@@ -927,6 +991,14 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now,
 		EndsAt:    now,
 		UpdatedAt: now,
+		Revisions: []*pb.Revision{{
+			Action:    pb.Revision_EXPIRE,
+			SilenceId: "pending",
+			Timestamp: now,
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now,
+			EndsAt:    now,
+		}},
 	}, sil)
 
 	// Let time pass...
@@ -953,6 +1025,14 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now.Add(-time.Minute),
 		EndsAt:    now,
 		UpdatedAt: now,
+		Revisions: []*pb.Revision{{
+			Action:    pb.Revision_EXPIRE,
+			SilenceId: "active",
+			Timestamp: now,
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now.Add(-time.Minute),
+			EndsAt:    now,
+		}},
 	}, sil)
 
 	sil, err = s.QueryOne(QIDs("expired"))
@@ -966,6 +1046,46 @@ func TestSilenceExpire(t *testing.T) {
 	}, sil)
 }
 
+func TestSilenceRevisionsLimit(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	s.clock = clock
+
+	now := s.nowUTC()
+	sil := &pb.Silence{
+		Matchers:  []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "me",
+		Comment:   "comment",
+	}
+	id, err := s.Set(sil)
+	require.NoError(t, err)
+
+	// Every update adds a revision, the oldest ones are dropped.
+	for i := 1; i <= maxRevisions+10; i++ {
+		clock.Add(time.Second)
+		sil, err = s.QueryOne(QIDs(id))
+		require.NoError(t, err)
+		sil = cloneSilence(sil)
+		sil.EndsAt = now.Add(time.Hour + time.Duration(i)*time.Minute)
+		_, err = s.Set(sil)
+		require.NoError(t, err)
+	}
+	clock.Add(time.Second)
+	require.NoError(t, s.ExpireWithAuthor(id, "you"))
+
+	sil, err = s.QueryOne(QIDs(id))
+	require.NoError(t, err)
+	require.Len(t, sil.Revisions, maxRevisions)
+	require.Equal(t, pb.Revision_UPDATE, sil.Revisions[0].Action)
+	require.Equal(t, now.Add(time.Hour+12*time.Minute), sil.Revisions[0].EndsAt)
+	last := sil.Revisions[maxRevisions-1]
+	require.Equal(t, pb.Revision_EXPIRE, last.Action)
+	require.Equal(t, "you", last.Author)
+}
+
 // TestSilenceExpireWithZeroRetention covers the problem that, with zero
 // retention time, a silence explicitly set to expired will also immediately
 // expire from the silence storage.
@@ -1083,6 +1203,134 @@ func TestSilenceExpireInvalid(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestSilencesChangeCallback(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	peer, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	var changed []*pb.Silence
+	s.SetChangeCallback(func(sil *pb.Silence) { changed = append(changed, sil) })
+	var broadcasts [][]byte
+	peer.SetBroadcast(func(b []byte) { broadcasts = append(broadcasts, b) })
+
+	now := s.nowUTC()
+	id, err := s.Set(&pb.Silence{
+		Matchers:  []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "me",
+		Comment:   "comment",
+	})
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, id, changed[0].Id)
+
+	require.NoError(t, s.Expire(id))
+	require.Len(t, changed, 2)
+	require.Equal(t, id, changed[1].Id)
+	require.False(t, changed[1].EndsAt.After(s.nowUTC()))
+
+	// Silences merged from a peer are reported too, but only once.
+	_, err = peer.Set(&pb.Silence{
+		Matchers:  []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "c", Pattern: "d"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "you",
+		Comment:   "comment",
+	})
+	require.NoError(t, err)
+	require.Len(t, broadcasts, 1)
+	require.NoError(t, s.Merge(broadcasts[0]))
+	require.NoError(t, s.Merge(broadcasts[0]))
+	require.Len(t, changed, 3)
+	require.Equal(t, "you", changed[2].CreatedBy)
+}
+
+func TestSilencesChangeCallbackEnded(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	clock := clock.NewMock()
+	s.clock = clock
+	s.expiredUntil = s.nowUTC()
+
+	var changed []*pb.Silence
+	s.SetChangeCallback(func(sil *pb.Silence) { changed = append(changed, sil) })
+
+	now := s.nowUTC()
+	set := func(d time.Duration) string {
+		id, err := s.Set(&pb.Silence{
+			Matchers:  []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+			StartsAt:  now,
+			EndsAt:    now.Add(d),
+			CreatedBy: "me",
+			Comment:   "comment",
+		})
+		require.NoError(t, err)
+		return id
+	}
+	id1, id2 := set(time.Hour), set(3*time.Hour)
+	changed = nil
+
+	clock.Add(30 * time.Minute)
+	require.Equal(t, now.Add(time.Hour), s.reportExpired())
+	require.Empty(t, changed)
+
+	// The silence which ended since the last run is reported once.
+	clock.Add(time.Hour)
+	require.Equal(t, now.Add(3*time.Hour), s.reportExpired())
+	require.Len(t, changed, 1)
+	require.Equal(t, id1, changed[0].Id)
+	s.reportExpired()
+	require.Len(t, changed, 1)
+
+	// Explicitly expired silences aren't reported again.
+	clock.Add(10 * time.Minute)
+	require.NoError(t, s.Expire(id2))
+	require.Len(t, changed, 2)
+	require.True(t, s.reportExpired().IsZero())
+	require.Len(t, changed, 2)
+}
+
+func TestSilencesMaintenanceReportsExpired(t *testing.T) {
+	s, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	changed := make(chan string, 10)
+	s.SetChangeCallback(func(sil *pb.Silence) { changed <- sil.Id })
+
+	stopc := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.Maintenance(time.Hour, "", stopc, func() (int64, error) { return 0, nil })
+		close(done)
+	}()
+	defer func() {
+		close(stopc)
+		<-done
+	}()
+
+	// The silence is reported when it ends, long before the next
+	// maintenance.
+	now := time.Now().UTC()
+	id, err := s.Set(&pb.Silence{
+		Matchers:  []*pb.Matcher{{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}},
+		StartsAt:  now,
+		EndsAt:    now.Add(100 * time.Millisecond),
+		CreatedBy: "me",
+		Comment:   "comment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, id, <-changed)
+
+	select {
+	case got := <-changed:
+		require.Equal(t, id, got)
+	case <-time.After(time.Second):
+		t.Fatal("expected the silence to be reported when it ends")
+	}
+}
+
 func TestSilencer(t *testing.T) {
 	ss, err := New(Options{Retention: time.Hour})
 	require.NoError(t, err)
@@ -1092,7 +1340,7 @@ func TestSilencer(t *testing.T) {
 	now := ss.nowUTC()
 
 	m := types.NewMarker(prometheus.NewRegistry())
-	s := NewSilencer(ss, m, log.NewNopLogger())
+	s := NewSilencer(ss, m, nil, log.NewNopLogger())
 
 	require.False(t, s.Mutes(model.LabelSet{"foo": "bar"}), "expected alert not silenced without any silences")
 
@@ -1156,6 +1404,56 @@ func TestSilencer(t *testing.T) {
 	require.True(t, s.Mutes(model.LabelSet{"foo": "bar"}), "expected alert silenced by activated second silence")
 }
 
+func TestSilencerTimeIntervals(t *testing.T) {
+	ss, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	clock.Set(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC))
+	ss.clock = clock
+	now := ss.nowUTC()
+
+	ti := timeinterval.NewIntervener(map[string][]timeinterval.TimeInterval{
+		"business-hours": {{Times: []timeinterval.TimeRange{{StartMinute: 9 * 60, EndMinute: 17 * 60}}}},
+	})
+	m := types.NewMarker(prometheus.NewRegistry())
+	s := NewSilencer(ss, m, ti, log.NewNopLogger())
+
+	lset := model.LabelSet{"foo": "bar"}
+	id, err := ss.Set(&pb.Silence{
+		Matchers:      []*pb.Matcher{{Name: "foo", Pattern: "bar"}},
+		StartsAt:      now,
+		EndsAt:        now.Add(24 * time.Hour),
+		TimeIntervals: []string{"business-hours"},
+	})
+	require.NoError(t, err)
+
+	require.False(t, s.Mutes(lset), "expected alert not silenced outside of the time interval")
+	_, pendingIDs, _, _ := m.Silenced(lset.Fingerprint())
+	require.Equal(t, []string{id}, pendingIDs)
+
+	clock.Add(time.Hour)
+	require.True(t, s.Mutes(lset), "expected alert silenced in the time interval")
+
+	clock.Add(8 * time.Hour)
+	require.False(t, s.Mutes(lset), "expected alert not silenced after the time interval")
+
+	// Silences referencing unknown time intervals don't mute alerts.
+	clock.Add(16 * time.Hour)
+	now = ss.nowUTC()
+	_, err = ss.Set(&pb.Silence{
+		Matchers:      []*pb.Matcher{{Name: "foo", Pattern: "bar"}},
+		StartsAt:      now,
+		EndsAt:        now.Add(time.Hour),
+		TimeIntervals: []string{"unknown"},
+	})
+	require.NoError(t, err)
+	require.False(t, s.Mutes(lset), "expected alert not silenced by silence with unknown time interval")
+
+	s = NewSilencer(ss, m, nil, log.NewNopLogger())
+	require.False(t, s.Mutes(lset), "expected alert not silenced without time intervals")
+}
+
 func TestValidateClassicMatcher(t *testing.T) {
 	cases := []struct {
 		m   *pb.Matcher
@@ -1464,6 +1762,19 @@ func TestValidateSilence(t *testing.T) {
 			},
 			err: "invalid zero update timestamp",
 		},
+		{
+			s: &pb.Silence{
+				Id: "some_id",
+				Matchers: []*pb.Matcher{
+					{Name: "a", Pattern: "b"},
+				},
+				StartsAt:      validTimestamp,
+				EndsAt:        validTimestamp,
+				UpdatedAt:     validTimestamp,
+				TimeIntervals: []string{""},
+			},
+			err: "empty time interval name",
+		},
 	}
 	for _, c := range cases {
 		checkErr(t, c.err, validateSilence(c.s))
@@ -1628,6 +1939,7 @@ func benchmarkSilencesQuery(b *testing.B, numSilences int) {
 			UpdatedAt: now.Add(-time.Hour),
 		}}
 	}
+	s.idx = newMatcherIndex(s.st)
 
 	// Run things once to populate the matcherCache.
 	sils, _, err := s.Query(
@@ -1660,6 +1972,52 @@ func Benchmark10000SilencesQuery(b *testing.B) {
 	benchmarkSilencesQuery(b, 10000)
 }
 
+func benchmarkSilencesQueryEqual(b *testing.B, numSilences int) {
+	s, err := New(Options{})
+	require.NoError(b, err)
+
+	clock := clock.NewMock()
+	s.clock = clock
+	now := clock.Now()
+
+	// Each silence matches a different team, the label set matches one of
+	// them.
+	for i := 0; i < numSilences; i++ {
+		_, err := s.Set(&pb.Silence{
+			Matchers: []*pb.Matcher{
+				{Type: pb.Matcher_EQUAL, Name: "team", Pattern: fmt.Sprint("team-", i)},
+				{Type: pb.Matcher_REGEXP, Name: "instance", Pattern: "web-.+"},
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		})
+		require.NoError(b, err)
+	}
+	lset := model.LabelSet{"alertname": "HighLatency", "team": "team-0", "instance": "web-1"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sils, _, err := s.Query(
+			QState(types.SilenceStateActive),
+			QMatches(lset),
+		)
+		require.NoError(b, err)
+		require.Len(b, sils, 1)
+	}
+}
+
+func Benchmark100SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 100)
+}
+
+func Benchmark1000SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 1000)
+}
+
+func Benchmark10000SilencesQueryEqual(b *testing.B) {
+	benchmarkSilencesQueryEqual(b, 10000)
+}
+
 // runtime.Gosched() does not "suspend" the current goroutine so there's no guarantee that the main goroutine won't
 // be able to continue. For more see https://pkg.go.dev/runtime#Gosched.
 func gosched() {
@@ -61,6 +61,34 @@ func (Matcher_Type) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7fc56058cf68dbd8, []int{0, 0}
 }
 
+type Revision_Action int32
+
+const (
+	Revision_CREATE Revision_Action = 0
+	Revision_UPDATE Revision_Action = 1
+	Revision_EXPIRE Revision_Action = 2
+)
+
+var Revision_Action_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "EXPIRE",
+}
+
+var Revision_Action_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"EXPIRE": 2,
+}
+
+func (x Revision_Action) String() string {
+	return proto.EnumName(Revision_Action_name, int32(x))
+}
+
+func (Revision_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{2, 0}
+}
+
 // Matcher specifies a rule, which can match or set of labels or not.
 type Matcher struct {
 	Type Matcher_Type `protobuf:"varint,1,opt,name=type,proto3,enum=silencepb.Matcher_Type" json:"type,omitempty"`
@@ -150,6 +178,58 @@ func (m *Comment) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Comment proto.InternalMessageInfo
 
+// Revision records a change made to a silence, together with the state of
+// the silence after the change.
+type Revision struct {
+	Action Revision_Action `protobuf:"varint,1,opt,name=action,proto3,enum=silencepb.Revision_Action" json:"action,omitempty"`
+	// The ID of the silence the change was made to. It differs from the ID of
+	// the silence holding the revision for the revisions inherited from the
+	// silence it replaced.
+	SilenceId            string     `protobuf:"bytes,2,opt,name=silence_id,json=silenceId,proto3" json:"silence_id,omitempty"`
+	Author               string     `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Timestamp            time.Time  `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Matchers             []*Matcher `protobuf:"bytes,5,rep,name=matchers,proto3" json:"matchers,omitempty"`
+	StartsAt             time.Time  `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3,stdtime" json:"starts_at"`
+	EndsAt               time.Time  `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at"`
+	Comment              string     `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fc56058cf68dbd8, []int{2}
+}
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
 // Silence specifies an object that ignores alerts based
 // on a set of matchers during a given time frame.
 type Silence struct {
@@ -166,18 +246,24 @@ type Silence struct {
 	// DEPRECATED: A set of comments made on the silence.
 	Comments []*Comment `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
 	// Comment for the silence.
-	CreatedBy            string   `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Comment              string   `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Comment   string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Names of time intervals of the configuration. If set, the silence only
+	// mutes alerts while the current time is in one of them.
+	TimeIntervals []string `protobuf:"bytes,10,rep,name=time_intervals,json=timeIntervals,proto3" json:"time_intervals,omitempty"`
+	// The changes made to the silence and to the silences it replaced, oldest
+	// first.
+	Revisions            []*Revision `protobuf:"bytes,11,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Silence) Reset()         { *m = Silence{} }
 func (m *Silence) String() string { return proto.CompactTextString(m) }
 func (*Silence) ProtoMessage()    {}
 func (*Silence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7fc56058cf68dbd8, []int{2}
+	return fileDescriptor_7fc56058cf68dbd8, []int{3}
 }
 func (m *Silence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +306,7 @@ func (m *MeshSilence) Reset()         { *m = MeshSilence{} }
 func (m *MeshSilence) String() string { return proto.CompactTextString(m) }
 func (*MeshSilence) ProtoMessage()    {}
 func (*MeshSilence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7fc56058cf68dbd8, []int{3}
+	return fileDescriptor_7fc56058cf68dbd8, []int{4}
 }
 func (m *MeshSilence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,8 +337,10 @@ var xxx_messageInfo_MeshSilence proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("silencepb.Matcher_Type", Matcher_Type_name, Matcher_Type_value)
+	proto.RegisterEnum("silencepb.Revision_Action", Revision_Action_name, Revision_Action_value)
 	proto.RegisterType((*Matcher)(nil), "silencepb.Matcher")
 	proto.RegisterType((*Comment)(nil), "silencepb.Comment")
+	proto.RegisterType((*Revision)(nil), "silencepb.Revision")
 	proto.RegisterType((*Silence)(nil), "silencepb.Silence")
 	proto.RegisterType((*MeshSilence)(nil), "silencepb.MeshSilence")
 }
@@ -260,36 +348,45 @@ func init() {
 func init() { proto.RegisterFile("silence.proto", fileDescriptor_7fc56058cf68dbd8) }
 
 var fileDescriptor_7fc56058cf68dbd8 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcd, 0x8e, 0xd2, 0x50,
-	0x14, 0xc7, 0xb9, 0x85, 0xa1, 0xdc, 0x43, 0x86, 0x90, 0x13, 0xa3, 0x0d, 0x89, 0x40, 0xba, 0x22,
-	0xd1, 0x94, 0x04, 0xb7, 0xba, 0x28, 0x13, 0xe2, 0xc6, 0xf1, 0xa3, 0x62, 0xe2, 0x6e, 0x52, 0xe8,
-	0x11, 0x9a, 0x4c, 0x3f, 0xd2, 0x1e, 0x12, 0x59, 0xe9, 0x23, 0xf8, 0x0c, 0x3e, 0x0d, 0x4b, 0x9f,
-	0xc0, 0x0f, 0xde, 0xc2, 0x9d, 0xe9, 0xed, 0x2d, 0xce, 0x84, 0x55, 0x77, 0xf7, 0xdc, 0xf3, 0xff,
-	0x9f, 0x8f, 0xdf, 0x81, 0xcb, 0x3c, 0xbc, 0xa5, 0x78, 0x4d, 0x4e, 0x9a, 0x25, 0x9c, 0xa0, 0xd4,
-	0x61, 0xba, 0x1a, 0x8c, 0x36, 0x49, 0xb2, 0xb9, 0xa5, 0xa9, 0x4a, 0xac, 0x76, 0x9f, 0xa6, 0x1c,
-	0x46, 0x94, 0xb3, 0x1f, 0xa5, 0xa5, 0x76, 0xf0, 0x60, 0x93, 0x6c, 0x12, 0xf5, 0x9c, 0x16, 0xaf,
-	0xf2, 0xd7, 0xfe, 0x2e, 0xc0, 0xbc, 0xf6, 0x79, 0xbd, 0xa5, 0x0c, 0x9f, 0x40, 0x8b, 0xf7, 0x29,
-	0x59, 0x62, 0x2c, 0x26, 0xbd, 0xd9, 0x23, 0xe7, 0x54, 0xdc, 0xd1, 0x0a, 0x67, 0xb9, 0x4f, 0xc9,
-	0x53, 0x22, 0x44, 0x68, 0xc5, 0x7e, 0x44, 0x96, 0x31, 0x16, 0x13, 0xe9, 0xa9, 0x37, 0x5a, 0x60,
-	0xa6, 0x3e, 0x33, 0x65, 0xb1, 0xd5, 0x54, 0xdf, 0x55, 0x68, 0x3f, 0x87, 0x56, 0xe1, 0x45, 0x09,
-	0x17, 0x8b, 0x77, 0x1f, 0xdc, 0x57, 0xfd, 0x06, 0x02, 0xb4, 0xbd, 0xc5, 0xcb, 0xc5, 0xc7, 0xb7,
-	0x7d, 0x81, 0x97, 0x20, 0x5f, 0xbf, 0x59, 0xde, 0x94, 0x29, 0x03, 0x7b, 0x00, 0x45, 0xa8, 0xd3,
-	0x4d, 0xfb, 0x0b, 0x98, 0x57, 0x49, 0x14, 0x51, 0xcc, 0xf8, 0x10, 0xda, 0xfe, 0x8e, 0xb7, 0x49,
-	0xa6, 0xa6, 0x94, 0x9e, 0x8e, 0x8a, 0xd6, 0xeb, 0x52, 0xa2, 0x27, 0xaa, 0x42, 0x9c, 0x83, 0x3c,
-	0xa1, 0x50, 0x63, 0x75, 0x67, 0x03, 0xa7, 0x84, 0xe5, 0x54, 0xb0, 0x9c, 0x65, 0xa5, 0x98, 0x77,
-	0x0e, 0x3f, 0x47, 0x8d, 0x6f, 0xbf, 0x46, 0xc2, 0xfb, 0x6f, 0xb3, 0xff, 0x1a, 0x60, 0xbe, 0x2f,
-	0x69, 0x60, 0x0f, 0x8c, 0x30, 0xd0, 0xdd, 0x8d, 0x30, 0x40, 0x07, 0x3a, 0x51, 0x89, 0x27, 0xb7,
-	0x8c, 0x71, 0x73, 0xd2, 0x9d, 0xe1, 0x39, 0x39, 0xef, 0xa4, 0x41, 0x17, 0x64, 0xce, 0x7e, 0xc6,
-	0xf9, 0x8d, 0xcf, 0xb5, 0xe6, 0xe9, 0x94, 0x36, 0x97, 0xf1, 0x05, 0x98, 0x14, 0x07, 0xaa, 0x40,
-	0xab, 0x46, 0x81, 0x76, 0x61, 0x72, 0x19, 0xaf, 0x00, 0x76, 0x69, 0xe0, 0x33, 0x05, 0x45, 0x85,
-	0x8b, 0x3a, 0x48, 0xb4, 0xcf, 0xe5, 0x62, 0x6d, 0x4d, 0x38, 0xb7, 0xcc, 0xb3, 0xb5, 0xf5, 0xb9,
-	0xbc, 0x93, 0x06, 0x1f, 0x03, 0xac, 0x33, 0x52, 0x4d, 0x57, 0x7b, 0xab, 0xa3, 0xf0, 0x49, 0xfd,
-	0x33, 0xdf, 0xdf, 0xbd, 0x9f, 0xbc, 0x77, 0x3f, 0xfb, 0xab, 0x80, 0xee, 0x35, 0xe5, 0xdb, 0x8a,
-	0xff, 0x53, 0x30, 0x75, 0x1f, 0x75, 0x84, 0xfb, 0x7d, 0xb5, 0xc8, 0xab, 0x24, 0xc5, 0xae, 0xf4,
-	0x39, 0x0d, 0x33, 0x52, 0xb4, 0x8c, 0x3a, 0xbb, 0x6a, 0x9f, 0xcb, 0xf3, 0xfe, 0xe1, 0xcf, 0xb0,
-	0x71, 0x38, 0x0e, 0xc5, 0x8f, 0xe3, 0x50, 0xfc, 0x3e, 0x0e, 0xc5, 0xaa, 0xad, 0xac, 0xcf, 0xfe,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0x09, 0x3a, 0xe9, 0x90, 0x03, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0xde, 0x24, 0xdd, 0xa4, 0x73, 0xca, 0x96, 0x32, 0x8a, 0x86, 0x82, 0x6d, 0x09, 0x08, 0x05,
+	0x97, 0x14, 0xeb, 0xad, 0x5e, 0xa4, 0x35, 0x48, 0xc1, 0xd5, 0x3a, 0x76, 0x61, 0xef, 0x4a, 0xda,
+	0x8c, 0x6d, 0xa0, 0xf9, 0x21, 0x99, 0x2e, 0xf6, 0x4a, 0x1f, 0xc1, 0x67, 0xf0, 0x15, 0x7c, 0x89,
+	0x5e, 0x0a, 0xde, 0xfb, 0xd3, 0x27, 0x91, 0x99, 0x4c, 0xba, 0x0d, 0xeb, 0x4d, 0xd7, 0xbb, 0x73,
+	0xce, 0x7c, 0xdf, 0xcc, 0x39, 0xdf, 0x77, 0x06, 0xce, 0xb2, 0x60, 0x45, 0xa3, 0x39, 0xb5, 0x93,
+	0x34, 0x66, 0x31, 0x46, 0x32, 0x4d, 0x66, 0xcd, 0xf6, 0x22, 0x8e, 0x17, 0x2b, 0xda, 0x13, 0x07,
+	0xb3, 0xf5, 0x87, 0x1e, 0x0b, 0x42, 0x9a, 0x31, 0x2f, 0x4c, 0x72, 0x6c, 0xf3, 0xfe, 0x22, 0x5e,
+	0xc4, 0x22, 0xec, 0xf1, 0x28, 0xaf, 0x5a, 0x5f, 0x15, 0x30, 0x2e, 0x3c, 0x36, 0x5f, 0xd2, 0x14,
+	0x3f, 0x81, 0x0a, 0xdb, 0x24, 0xd4, 0x54, 0x3a, 0x4a, 0xb7, 0xde, 0x7f, 0x68, 0xef, 0x2f, 0xb7,
+	0x25, 0xc2, 0x9e, 0x6c, 0x12, 0x4a, 0x04, 0x08, 0x63, 0xa8, 0x44, 0x5e, 0x48, 0x4d, 0xb5, 0xa3,
+	0x74, 0x11, 0x11, 0x31, 0x36, 0xc1, 0x48, 0x3c, 0xc6, 0x68, 0x1a, 0x99, 0x9a, 0x28, 0x17, 0xa9,
+	0xf5, 0x1c, 0x2a, 0x9c, 0x8b, 0x11, 0x9c, 0xba, 0xef, 0x2e, 0x9d, 0xd7, 0x8d, 0x13, 0x0c, 0xa0,
+	0x13, 0xf7, 0x95, 0x7b, 0x35, 0x6e, 0x28, 0xf8, 0x0c, 0xd0, 0x9b, 0xb7, 0x93, 0x69, 0x7e, 0xa4,
+	0xe2, 0x3a, 0x00, 0x4f, 0xe5, 0xb1, 0x66, 0x7d, 0x02, 0x63, 0x18, 0x87, 0x21, 0x8d, 0x18, 0x7e,
+	0x00, 0xba, 0xb7, 0x66, 0xcb, 0x38, 0x15, 0x5d, 0x22, 0x22, 0x33, 0xfe, 0xf4, 0x3c, 0x87, 0xc8,
+	0x8e, 0x8a, 0x14, 0x0f, 0x00, 0xed, 0xa5, 0x10, 0x6d, 0xd5, 0xfa, 0x4d, 0x3b, 0x17, 0xcb, 0x2e,
+	0xc4, 0xb2, 0x27, 0x05, 0x62, 0x50, 0xdd, 0xfe, 0x6c, 0x9f, 0x7c, 0xf9, 0xd5, 0x56, 0xc8, 0x0d,
+	0xcd, 0xfa, 0xa6, 0x41, 0x95, 0xd0, 0xeb, 0x20, 0x0b, 0xe2, 0x08, 0xf7, 0x41, 0xf7, 0xe6, 0x2c,
+	0x88, 0x23, 0x29, 0x54, 0xf3, 0x40, 0xa8, 0x02, 0x64, 0x3b, 0x02, 0x41, 0x24, 0x12, 0x3f, 0x02,
+	0x90, 0xa0, 0x69, 0xe0, 0xcb, 0x0e, 0x0b, 0xf3, 0x46, 0xfe, 0xc1, 0x54, 0x5a, 0x69, 0xaa, 0x52,
+	0xef, 0x95, 0x3b, 0xf5, 0x8e, 0x6d, 0xa8, 0x86, 0xb9, 0x7d, 0x99, 0x79, 0xda, 0xd1, 0xba, 0xb5,
+	0x3e, 0xbe, 0xed, 0x2c, 0xd9, 0x63, 0xb0, 0x03, 0x28, 0x63, 0x5e, 0xca, 0xb2, 0xa9, 0xc7, 0x4c,
+	0xfd, 0x88, 0x37, 0xab, 0x39, 0xcd, 0x61, 0xf8, 0x05, 0x18, 0x34, 0xf2, 0xc5, 0x05, 0xc6, 0x11,
+	0x17, 0xe8, 0x9c, 0xe4, 0xb0, 0x43, 0x2f, 0xab, 0x25, 0x2f, 0xad, 0x73, 0xd0, 0x73, 0x61, 0xf9,
+	0xf6, 0x0c, 0x89, 0xeb, 0x4c, 0xdc, 0x7c, 0x93, 0x2e, 0xc7, 0x2f, 0x79, 0xac, 0xf0, 0xd8, 0xbd,
+	0x1a, 0x8f, 0x88, 0xdb, 0x50, 0xad, 0x1f, 0x1a, 0x18, 0xef, 0xf3, 0x49, 0x71, 0x1d, 0xd4, 0xc0,
+	0x97, 0x3b, 0xa3, 0x06, 0x7e, 0x49, 0x15, 0xf5, 0x58, 0x55, 0xb4, 0xff, 0x55, 0xa5, 0x72, 0x07,
+	0x55, 0x86, 0x00, 0xeb, 0xc4, 0xf7, 0x18, 0xf5, 0xf9, 0x0d, 0xa7, 0xc7, 0x2c, 0x83, 0xe4, 0x39,
+	0x8c, 0x8f, 0x2d, 0xb5, 0xcc, 0x4c, 0xe3, 0xd6, 0xd8, 0xf2, 0x93, 0x91, 0x3d, 0x86, 0xef, 0xed,
+	0x3c, 0xa5, 0xe2, 0xd1, 0xd9, 0x46, 0xba, 0x81, 0x64, 0x65, 0xb0, 0x39, 0x74, 0x0a, 0x95, 0x7f,
+	0xdd, 0x63, 0xa8, 0xf3, 0x15, 0x9c, 0x06, 0x11, 0xa3, 0xe9, 0xb5, 0xb7, 0xca, 0x4c, 0xe8, 0x68,
+	0x5d, 0x44, 0xce, 0x78, 0x75, 0x54, 0x14, 0xf1, 0x53, 0x40, 0xa9, 0xfc, 0x32, 0x99, 0x59, 0x13,
+	0x0d, 0xdd, 0xfb, 0xc7, 0x77, 0x22, 0x37, 0x28, 0xeb, 0xb3, 0x02, 0xb5, 0x0b, 0x9a, 0x2d, 0x0b,
+	0x67, 0xcf, 0xc1, 0x90, 0x04, 0x61, 0x6f, 0x79, 0x22, 0x09, 0x22, 0x05, 0x84, 0xab, 0x48, 0x3f,
+	0x26, 0x41, 0x4a, 0x85, 0x0f, 0xea, 0x31, 0x2a, 0x4a, 0x9e, 0xc3, 0x06, 0x8d, 0xed, 0x9f, 0xd6,
+	0xc9, 0x76, 0xd7, 0x52, 0xbe, 0xef, 0x5a, 0xca, 0xef, 0x5d, 0x4b, 0x99, 0xe9, 0x82, 0xfa, 0xec,
+	0xef, 0x00, 0x5c, 0x17, 0x1a, 0x68, 0xa0, 0x05, 0x00, 0x00,
 }
 
 func (m *Matcher) Marshal() (dAtA []byte, err error) {
@@ -387,6 +484,97 @@ func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Revision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSilence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSilence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Matchers) > 0 {
+		for iNdEx := len(m.Matchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSilence(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SilenceId) > 0 {
+		i -= len(m.SilenceId)
+		copy(dAtA[i:], m.SilenceId)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.SilenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Silence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -411,6 +599,29 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSilence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TimeIntervals) > 0 {
+		for iNdEx := len(m.TimeIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TimeIntervals[iNdEx])
+			copy(dAtA[i:], m.TimeIntervals[iNdEx])
+			i = encodeVarintSilence(dAtA, i, uint64(len(m.TimeIntervals[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Comment) > 0 {
 		i -= len(m.Comment)
 		copy(dAtA[i:], m.Comment)
@@ -439,28 +650,28 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintSilence(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintSilence(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintSilence(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintSilence(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x22
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintSilence(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintSilence(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.Matchers) > 0 {
@@ -511,12 +722,12 @@ func (m *MeshSilence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintSilence(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintSilence(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if m.Silence != nil {
@@ -590,6 +801,45 @@ func (m *Comment) Size() (n int) {
 	return n
 }
 
+func (m *Revision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovSilence(uint64(m.Action))
+	}
+	l = len(m.SilenceId)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSilence(uint64(l))
+	if len(m.Matchers) > 0 {
+		for _, e := range m.Matchers {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartsAt)
+	n += 1 + l + sovSilence(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt)
+	n += 1 + l + sovSilence(uint64(l))
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Silence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -626,6 +876,18 @@ func (m *Silence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSilence(uint64(l))
 	}
+	if len(m.TimeIntervals) > 0 {
+		for _, s := range m.TimeIntervals {
+			l = len(s)
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovSilence(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -938,7 +1200,7 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Silence) Unmarshal(dAtA []byte) error {
+func (m *Revision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,15 +1223,34 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Silence: wiretype end group for non-group")
+			return fmt.Errorf("proto: Revision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Silence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Revision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Revision_Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SilenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -997,13 +1278,13 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SilenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSilence
@@ -1013,29 +1294,27 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSilence
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSilence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Matchers = append(m.Matchers, &Matcher{})
-			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1062,13 +1341,13 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1095,13 +1374,14 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.Matchers = append(m.Matchers, &Matcher{})
+			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,13 +1408,13 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1161,14 +1441,13 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comments = append(m.Comments, &Comment{})
-			if err := m.Comments[len(m.Comments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1196,7 +1475,289 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSilence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Silence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSilence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Silence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Silence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Matchers = append(m.Matchers, &Matcher{})
+			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comments = append(m.Comments, &Comment{})
+			if err := m.Comments[len(m.Comments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
@@ -1230,6 +1791,72 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			}
 			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeIntervals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeIntervals = append(m.TimeIntervals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &Revision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSilence(dAtA[iNdEx:])
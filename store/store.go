@@ -33,8 +33,9 @@ var ErrNotFound = errors.New("alert not found")
 // resolved alerts that have been removed.
 type Alerts struct {
 	sync.Mutex
-	c  map[model.Fingerprint]*types.Alert
-	cb func([]*types.Alert)
+	c         map[model.Fingerprint]*types.Alert
+	cb        func([]*types.Alert)
+	retention time.Duration
 }
 
 // NewAlerts returns a new Alerts struct.
@@ -55,6 +56,15 @@ func (a *Alerts) SetGCCallback(cb func([]*types.Alert)) {
 	a.cb = cb
 }
 
+// SetGCRetention sets for how long resolved alerts are kept before being
+// garbage collected. By default, they are removed by the next GC.
+func (a *Alerts) SetGCRetention(d time.Duration) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.retention = d
+}
+
 // Run starts the GC loop. The interval must be greater than zero; if not, the function will panic.
 func (a *Alerts) Run(ctx context.Context, interval time.Duration) {
 	t := time.NewTicker(interval)
@@ -73,9 +83,12 @@ func (a *Alerts) gc() {
 	a.Lock()
 	defer a.Unlock()
 
-	var resolved []*types.Alert
+	var (
+		resolved []*types.Alert
+		ts       = time.Now().Add(-a.retention)
+	)
 	for fp, alert := range a.c {
-		if alert.Resolved() {
+		if alert.ResolvedAt(ts) {
 			delete(a.c, fp)
 			resolved = append(resolved, alert)
 		}
@@ -105,6 +118,18 @@ func (a *Alerts) Set(alert *types.Alert) error {
 	return nil
 }
 
+// SetWithCallback sets the alert in memory and calls cb with the lock held, so
+// that cb is ordered with the other changes of the store, including the ones
+// passed to the GC callback.
+func (a *Alerts) SetWithCallback(alert *types.Alert, cb func()) error {
+	a.Lock()
+	defer a.Unlock()
+
+	a.c[alert.Fingerprint()] = alert
+	cb()
+	return nil
+}
+
 // Delete removes the Alert with the matching fingerprint from the store.
 func (a *Alerts) Delete(fp model.Fingerprint) error {
 	a.Lock()
@@ -134,3 +159,11 @@ func (a *Alerts) Empty() bool {
 
 	return len(a.c) == 0
 }
+
+// Len returns the number of alerts in the store.
+func (a *Alerts) Len() int {
+	a.Lock()
+	defer a.Unlock()
+
+	return len(a.c)
+}
@@ -37,6 +37,24 @@ func TestSetGet(t *testing.T) {
 	require.Equal(t, want, got.Fingerprint())
 }
 
+func TestSetWithCallback(t *testing.T) {
+	a := NewAlerts()
+	alert := &types.Alert{
+		UpdatedAt: time.Now(),
+	}
+	var called bool
+	require.NoError(t, a.SetWithCallback(alert, func() {
+		// The callback is called with the lock held.
+		require.False(t, a.TryLock())
+		called = true
+	}))
+	require.True(t, called)
+
+	got, err := a.Get(alert.Fingerprint())
+	require.NoError(t, err)
+	require.Equal(t, alert, got)
+}
+
 func TestDelete(t *testing.T) {
 	a := NewAlerts()
 	alert := &types.Alert{
@@ -111,3 +129,29 @@ func TestGC(t *testing.T) {
 	}
 	require.Len(t, resolved, n)
 }
+
+func TestGCRetention(t *testing.T) {
+	now := time.Now()
+	newAlert := func(key string, end time.Duration) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{model.LabelName(key): "b"},
+				StartsAt: now.Add(-time.Hour),
+				EndsAt:   now.Add(end),
+			},
+		}
+	}
+	recent := newAlert("a", -time.Minute)
+	old := newAlert("b", -time.Hour)
+
+	s := NewAlerts()
+	s.SetGCRetention(10 * time.Minute)
+	require.NoError(t, s.Set(recent))
+	require.NoError(t, s.Set(old))
+	s.gc()
+
+	_, err := s.Get(recent.Fingerprint())
+	require.NoError(t, err)
+	_, err = s.Get(old.Fingerprint())
+	require.Equal(t, ErrNotFound, err)
+}
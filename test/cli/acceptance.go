@@ -577,6 +577,19 @@ func (am *Alertmanager) addSilenceCommand(sil *TestSilence) ([]byte, error) {
 	return cmd.CombinedOutput()
 }
 
+// PreviewSilence returns the alerts the given silence would mute using the
+// 'amtool silence add --dry-run' command.
+func (am *Alertmanager) PreviewSilence(sil *TestSilence) ([]TestAlert, error) {
+	amURLFlag := "--alertmanager.url=" + am.getURL("/")
+	args := append([]string{amURLFlag, "silence", "add", "--dry-run"}, sil.match...)
+	cmd := exec.Command(amtool, args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, out)
+	}
+	return parseAlertQueryResponse(out)
+}
+
 // QuerySilence queries the current silences using the 'amtool silence query' command.
 func (am *Alertmanager) QuerySilence(match ...string) ([]TestSilence, error) {
 	amURLFlag := "--alertmanager.url=" + am.getURL("/")
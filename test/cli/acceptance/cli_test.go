@@ -201,6 +201,52 @@ receivers:
 	require.Equal(t, expected1, sils[0].GetMatches())
 }
 
+func TestSilenceAddDryRun(t *testing.T) {
+	t.Parallel()
+
+	conf := `
+route:
+  receiver: "default"
+  group_by: [alertname]
+  group_wait:      1s
+  group_interval:  1s
+  repeat_interval: 1ms
+
+receivers:
+- name: "default"
+  webhook_configs:
+  - url: 'http://%s'
+    send_resolved: true
+`
+
+	at := NewAcceptanceTest(t, &AcceptanceOpts{
+		Tolerance: 1 * time.Second,
+	})
+	co := at.Collector("webhook")
+	wh := NewWebhook(co)
+
+	amc := at.AlertmanagerCluster(fmt.Sprintf(conf, wh.Address()), 1)
+	require.NoError(t, amc.Start())
+	defer amc.Terminate()
+
+	am := amc.Members()[0]
+
+	alert1 := Alert("alertname", "test1", "severity", "warning").Active(1)
+	alert2 := Alert("alertname", "test2", "severity", "warning").Active(1)
+	am.AddAlerts(true, alert1, alert2)
+
+	// The preview lists the alerts the silence would mute.
+	alerts, err := am.PreviewSilence(Silence(0, 4).Match("test1", "severity=warning"))
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	require.True(t, alerts[0].HasLabels(models.LabelSet{"alertname": "test1"}))
+
+	// No silence is created.
+	sils, err := am.QuerySilence()
+	require.NoError(t, err)
+	require.Empty(t, sils)
+}
+
 func TestRoutesShow(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,619 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeinterval
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Calendar is an iCalendar (RFC 5545) file whose events are ranges of time,
+// such as public holidays. Only the start, end, duration, simple recurrence
+// rules, and the occurrences added, excluded or modified of the events are
+// taken into account.
+type Calendar struct {
+	// Path of the iCalendar file.
+	Path string
+
+	events []event
+}
+
+// event is a range of time of a calendar, which may recur.
+type event struct {
+	start, end time.Time
+	// floating is whether the start and end times are local times, in which
+	// case they are kept in UTC and interpreted in the location of the time
+	// interval. All-day events are floating.
+	floating bool
+	rrule    *recurrence
+	// rdates are the starts of the occurrences added to the recurrence rule,
+	// and exdates the ones excluded from it.
+	rdates, exdates []dateTime
+
+	uid string
+	// recurrenceID is the start of the occurrence of the recurring event
+	// with the same UID which the event replaces, zero if none.
+	recurrenceID dateTime
+	cancelled    bool
+}
+
+// dateTime is a DATE or DATE-TIME value. Floating times are kept in UTC.
+type dateTime struct {
+	time.Time
+	floating bool
+}
+
+// in returns the time, with the wall clock of floating times in the given
+// location.
+func (d dateTime) in(loc *time.Location) time.Time {
+	if d.floating {
+		return resolveFloating(d.Time, loc)
+	}
+	return d.Time
+}
+
+type frequency int
+
+const (
+	daily frequency = iota
+	weekly
+	monthly
+	yearly
+)
+
+// recurrence is a recurrence rule repeating an event every interval periods.
+type recurrence struct {
+	freq     frequency
+	interval int
+	// count is the number of occurrences, zero if unlimited.
+	count int
+	// until is the start of the last possible occurrence, zero if unlimited.
+	until         time.Time
+	untilFloating bool
+}
+
+// SetDirectory joins the path of the calendar to the given directory if it is
+// relative.
+func (c *Calendar) SetDirectory(dir string) {
+	if c.Path != "" && !filepath.IsAbs(c.Path) {
+		c.Path = filepath.Join(dir, c.Path)
+	}
+}
+
+// Load reads and parses the iCalendar file of the calendar.
+func (c *Calendar) Load() error {
+	b, err := os.ReadFile(c.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read calendar: %w", err)
+	}
+	events, err := parseCalendar(b)
+	if err != nil {
+		return fmt.Errorf("failed to parse calendar %q: %w", c.Path, err)
+	}
+	c.events = events
+	return nil
+}
+
+// contains returns whether one of the events of the calendar contains the
+// given time. Floating times are interpreted in the location of t.
+func (c Calendar) contains(t time.Time) bool {
+	for _, e := range c.events {
+		if e.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalYAML implements the Unmarshaller interface for Calendar.
+func (c *Calendar) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		return fmt.Errorf("calendar path cannot be empty")
+	}
+	*c = Calendar{Path: str}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Calendar.
+// It delegates to the YAML unmarshaller as it can parse JSON and has validation logic.
+func (c *Calendar) UnmarshalJSON(in []byte) error {
+	return yaml.Unmarshal(in, c)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Calendar.
+func (c Calendar) MarshalYAML() (interface{}, error) {
+	return c.Path, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Calendar.
+func (c Calendar) MarshalJSON() (out []byte, err error) {
+	return json.Marshal(c.Path)
+}
+
+func (e event) contains(t time.Time) bool {
+	start, end := e.resolve(e.start, t.Location()), e.resolve(e.end, t.Location())
+	for _, r := range e.rdates {
+		s := r.in(t.Location())
+		// The wall clock of floating occurrences lasts as long as the event.
+		rend := dateTime{Time: r.Add(e.end.Sub(e.start)), floating: r.floating}.in(t.Location())
+		if !t.Before(s) && t.Before(rend) && !e.excluded(s) {
+			return true
+		}
+	}
+	if e.rrule == nil {
+		return !t.Before(start) && t.Before(end) && !e.excluded(start)
+	}
+	if t.Before(start) {
+		return false
+	}
+
+	var until time.Time
+	if !e.rrule.until.IsZero() {
+		until = e.rrule.until
+		if e.rrule.untilFloating {
+			until = resolveFloating(until, start.Location())
+		}
+	}
+	// The occurrence starting in the period of t might start after t, and
+	// the previous occurrence might still be ongoing.
+	n := e.rrule.periods(start, t)
+	for i := n; i >= 0 && i >= n-1; i-- {
+		s, ok := e.rrule.occurrence(start, i)
+		if !ok || (!until.IsZero() && s.After(until)) {
+			continue
+		}
+		if !t.Before(s) && t.Before(e.rrule.add(end, i)) && !e.excluded(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// excluded returns whether the occurrence starting at s is excluded from the
+// event.
+func (e event) excluded(s time.Time) bool {
+	for _, x := range e.exdates {
+		if x.in(s.Location()).Equal(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e event) resolve(t time.Time, loc *time.Location) time.Time {
+	if e.floating {
+		return resolveFloating(t, loc)
+	}
+	return t
+}
+
+// resolveFloating returns the time with the wall clock of t in the given
+// location.
+func resolveFloating(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// periods returns the number of whole periods of the rule between the start
+// of the first occurrence and t.
+func (r *recurrence) periods(start, t time.Time) int {
+	t = t.In(start.Location())
+	var n int
+	switch r.freq {
+	case yearly:
+		n = t.Year() - start.Year()
+	case monthly:
+		n = (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	case daily, weekly:
+		// Count calendar days, which aren't always 24 hours long.
+		d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).
+			Sub(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC))
+		n = int(d.Hours() / 24)
+		if r.freq == weekly {
+			n /= 7
+		}
+	}
+	return n / r.interval
+}
+
+// occurrence returns the start of the i-th period of the rule, and whether it
+// is an occurrence of the event. Periods falling on days which their month
+// doesn't have, such as February 30, are skipped, and don't count towards the
+// count of the rule.
+func (r *recurrence) occurrence(start time.Time, i int) (time.Time, bool) {
+	s := r.add(start, i)
+	if !r.exists(start, s) {
+		return s, false
+	}
+	if r.count > 0 {
+		n := 0
+		for j := 0; j < i && n < r.count; j++ {
+			if r.exists(start, r.add(start, j)) {
+				n++
+			}
+		}
+		if n >= r.count {
+			return s, false
+		}
+	}
+	return s, true
+}
+
+// exists returns whether s, the start of the event moved by the rule, falls on
+// the same day of the month as the start.
+func (r *recurrence) exists(start, s time.Time) bool {
+	return (r.freq != monthly && r.freq != yearly) || s.Day() == start.Day()
+}
+
+// add returns t moved by i times the interval of the rule. Days which the
+// month doesn't have are normalized as by time.Time.AddDate.
+func (r *recurrence) add(t time.Time, i int) time.Time {
+	switch r.freq {
+	case yearly:
+		return t.AddDate(i*r.interval, 0, 0)
+	case monthly:
+		return t.AddDate(0, i*r.interval, 0)
+	case weekly:
+		return t.AddDate(0, 0, 7*i*r.interval)
+	default:
+		return t.AddDate(0, 0, i*r.interval)
+	}
+}
+
+// contentLine is a property of an iCalendar file.
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseCalendar parses the events of an iCalendar file.
+func parseCalendar(b []byte) ([]event, error) {
+	lines, err := unfoldLines(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events     []event
+		components []string
+		props      []contentLine
+	)
+	for i, l := range lines {
+		cl, err := parseContentLine(l)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		switch cl.name {
+		case "BEGIN":
+			c := strings.ToUpper(cl.value)
+			components = append(components, c)
+			if c == "VEVENT" {
+				props = props[:0]
+			}
+			continue
+		case "END":
+			c := strings.ToUpper(cl.value)
+			if len(components) == 0 || components[len(components)-1] != c {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, cl.value)
+			}
+			components = components[:len(components)-1]
+			if c == "VEVENT" {
+				e, err := parseEvent(props)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", i+1, err)
+				}
+				events = append(events, e)
+			}
+			continue
+		}
+		// Properties of nested components, such as alarms, are ignored.
+		if len(components) > 0 && components[len(components)-1] == "VEVENT" {
+			props = append(props, cl)
+		}
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("missing END:%s", components[len(components)-1])
+	}
+
+	// Modified occurrences of recurring events are events of their own, which
+	// replace the occurrence of the event with the same UID.
+	for _, o := range events {
+		if o.recurrenceID.IsZero() {
+			continue
+		}
+		for i := range events {
+			if events[i].uid == o.uid && events[i].recurrenceID.IsZero() {
+				events[i].exdates = append(events[i].exdates, o.recurrenceID)
+			}
+		}
+	}
+	active := events[:0]
+	for _, e := range events {
+		if !e.cancelled {
+			active = append(active, e)
+		}
+	}
+	return active, nil
+}
+
+// unfoldLines splits the content into lines, joining the lines folded onto
+// several ones.
+func unfoldLines(b []byte) ([]string, error) {
+	var (
+		lines []string
+		s     = bufio.NewScanner(bytes.NewReader(b))
+	)
+	for s.Scan() {
+		l := strings.TrimRight(s.Text(), "\r")
+		if len(l) > 0 && (l[0] == ' ' || l[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines, s.Err()
+}
+
+// parseContentLine parses a line of the form NAME;PARAM=VALUE:VALUE.
+func parseContentLine(l string) (contentLine, error) {
+	cl := contentLine{params: map[string]string{}}
+
+	// Parameter values may contain colons if they are quoted.
+	quoted, sep := false, -1
+	for i, c := range l {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		return cl, fmt.Errorf("invalid content line %q", l)
+	}
+	cl.value = l[sep+1:]
+
+	parts := strings.Split(l[:sep], ";")
+	cl.name = strings.ToUpper(parts[0])
+	for _, p := range parts[1:] {
+		k, v, ok := strings.Cut(p, "=")
+		if !ok {
+			return cl, fmt.Errorf("invalid parameter %q", p)
+		}
+		cl.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return cl, nil
+}
+
+func parseEvent(props []contentLine) (event, error) {
+	var (
+		e                             event
+		hasStart, hasEnd, hasDuration bool
+		allDay                        bool
+		duration                      time.Duration
+		rrule                         string
+	)
+	for _, p := range props {
+		var err error
+		switch p.name {
+		case "UID":
+			e.uid = p.value
+		case "DTSTART":
+			e.start, e.floating, allDay, err = parseDateTime(p)
+			hasStart = true
+		case "DTEND":
+			e.end, _, _, err = parseDateTime(p)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseDuration(p.value)
+			hasDuration = true
+		case "RRULE":
+			rrule = p.value
+		case "RDATE":
+			if p.params["VALUE"] == "PERIOD" {
+				err = fmt.Errorf("unsupported RDATE period %q", p.value)
+				break
+			}
+			var ds []dateTime
+			ds, err = parseDateTimes(p)
+			e.rdates = append(e.rdates, ds...)
+		case "EXDATE":
+			var ds []dateTime
+			ds, err = parseDateTimes(p)
+			e.exdates = append(e.exdates, ds...)
+		case "RECURRENCE-ID":
+			if r, ok := p.params["RANGE"]; ok {
+				err = fmt.Errorf("unsupported RECURRENCE-ID range %q", r)
+				break
+			}
+			e.recurrenceID.Time, e.recurrenceID.floating, _, err = parseDateTime(p)
+		case "STATUS":
+			e.cancelled = strings.EqualFold(p.value, "CANCELLED")
+		}
+		if err != nil {
+			return e, fmt.Errorf("event %q: %w", e.uid, err)
+		}
+	}
+	if !hasStart {
+		return e, fmt.Errorf("event %q: missing DTSTART", e.uid)
+	}
+
+	switch {
+	case hasEnd:
+	case hasDuration:
+		e.end = e.start.Add(duration)
+	case allDay:
+		// An all-day event without end lasts for the day.
+		e.end = e.start.AddDate(0, 0, 1)
+	default:
+		e.end = e.start
+	}
+	if e.end.Before(e.start) {
+		return e, fmt.Errorf("event %q: end is before start", e.uid)
+	}
+
+	if rrule != "" {
+		r, err := parseRecurrence(rrule, e.start)
+		if err != nil {
+			return e, fmt.Errorf("event %q: %w", e.uid, err)
+		}
+		e.rrule = r
+	}
+	return e, nil
+}
+
+// parseDateTime parses a DATE or DATE-TIME value. Local times are returned in
+// UTC along with floating set to true.
+func parseDateTime(p contentLine) (t time.Time, floating, allDay bool, err error) {
+	v := p.value
+	if p.params["VALUE"] == "DATE" || len(v) == len("20060102") {
+		t, err = time.Parse("20060102", v)
+		return t, true, true, err
+	}
+
+	if strings.HasSuffix(v, "Z") {
+		t, err = time.Parse("20060102T150405Z", v)
+		return t, false, false, err
+	}
+	if tzid, ok := p.params["TZID"]; ok {
+		loc, err := time.LoadLocation(tzid)
+		if err != nil {
+			return t, false, false, err
+		}
+		t, err = time.ParseInLocation("20060102T150405", v, loc)
+		return t, false, false, err
+	}
+	t, err = time.Parse("20060102T150405", v)
+	return t, true, false, err
+}
+
+// parseDateTimes parses a comma-separated list of DATE or DATE-TIME values.
+func parseDateTimes(p contentLine) ([]dateTime, error) {
+	var ds []dateTime
+	for _, v := range strings.Split(p.value, ",") {
+		var (
+			d   dateTime
+			err error
+		)
+		d.Time, d.floating, _, err = parseDateTime(contentLine{params: p.params, value: v})
+		if err != nil {
+			return nil, err
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+var durationRE = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration parses a DURATION value, such as P1D or PT1H30M.
+func parseDuration(v string) (time.Duration, error) {
+	m := durationRE.FindStringSubmatch(v)
+	if m == nil || v == "P" || strings.HasSuffix(v, "T") {
+		return 0, fmt.Errorf("invalid duration %q", v)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		return 0, fmt.Errorf("negative duration %q", v)
+	}
+	return d, nil
+}
+
+// parseRecurrence parses a RRULE value. Only the FREQ, INTERVAL, COUNT and
+// UNTIL parts are supported, along with BYMONTH and BYMONTHDAY parts matching
+// the start of the event, as commonly found in calendars of holidays.
+func parseRecurrence(v string, start time.Time) (*recurrence, error) {
+	r := &recurrence{interval: 1, freq: -1}
+	for _, part := range strings.Split(v, ";") {
+		k, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			switch strings.ToUpper(val) {
+			case "DAILY":
+				r.freq = daily
+			case "WEEKLY":
+				r.freq = weekly
+			case "MONTHLY":
+				r.freq = monthly
+			case "YEARLY":
+				r.freq = yearly
+			default:
+				return nil, fmt.Errorf("unsupported recurrence frequency %q", val)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(val)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("invalid recurrence interval %q", val)
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(val)
+			if err == nil && r.count < 1 {
+				err = fmt.Errorf("invalid recurrence count %q", val)
+			}
+		case "UNTIL":
+			r.until, r.untilFloating, _, err = parseDateTime(contentLine{value: val})
+		case "BYMONTH":
+			if val != strconv.Itoa(int(start.Month())) {
+				err = fmt.Errorf("unsupported recurrence rule part %q", part)
+			}
+		case "BYMONTHDAY":
+			if val != strconv.Itoa(start.Day()) {
+				err = fmt.Errorf("unsupported recurrence rule part %q", part)
+			}
+		case "WKST":
+			// The start of the week only matters for parts which aren't
+			// supported.
+		default:
+			err = fmt.Errorf("unsupported recurrence rule part %q", part)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.freq < 0 {
+		return nil, fmt.Errorf("missing recurrence frequency in %q", v)
+	}
+	return r, nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeinterval
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const testCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Holidays//EN
+BEGIN:VEVENT
+UID:new-year
+DTSTART;VALUE=DATE:20200101
+DTEND;VALUE=DATE:20200102
+RRULE:FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1
+SUMMARY:New Year's
+  Day
+BEGIN:VALARM
+TRIGGER:-PT15M
+ACTION:DISPLAY
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:shutdown
+DTSTART;VALUE=DATE:20241223
+DTEND;VALUE=DATE:20241228
+END:VEVENT
+BEGIN:VEVENT
+UID:maintenance
+DTSTART:20240301T220000Z
+DURATION:PT4H
+RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=3
+END:VEVENT
+BEGIN:VEVENT
+UID:standup
+DTSTART;TZID=Europe/Berlin:20240102T093000
+DTEND;TZID=Europe/Berlin:20240102T094500
+RRULE:FREQ=WEEKLY;UNTIL=20240130T235959Z
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestCalendarContains(t *testing.T) {
+	events, err := parseCalendar([]byte(testCalendar))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	c := Calendar{events: events}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		time     string
+		loc      *time.Location
+		contains bool
+	}{
+		// Yearly all-day events recur every year.
+		{time: "2020-01-01T00:00:00Z", contains: true},
+		{time: "2031-01-01T23:59:59Z", contains: true},
+		{time: "2031-01-02T00:00:00Z", contains: false},
+		{time: "2019-01-01T12:00:00Z", contains: false},
+		// All-day events are in the location of the time interval.
+		{time: "2030-12-31T23:30:00Z", loc: berlin, contains: true},
+		{time: "2030-12-31T23:30:00Z", contains: false},
+		// The end date of all-day events is exclusive.
+		{time: "2024-12-27T12:00:00Z", contains: true},
+		{time: "2024-12-28T00:00:00Z", contains: false},
+		// Monthly events with a duration, limited by a count.
+		{time: "2024-03-02T01:59:00Z", contains: true},
+		{time: "2024-03-02T02:00:00Z", contains: false},
+		{time: "2024-04-01T23:00:00Z", contains: false},
+		{time: "2024-07-01T23:00:00Z", contains: true},
+		{time: "2024-09-01T23:00:00Z", contains: false},
+		// Weekly events in a time zone, limited by an end date.
+		{time: "2024-01-16T08:40:00Z", contains: true},
+		{time: "2024-01-16T09:40:00Z", contains: false},
+		{time: "2024-01-17T08:40:00Z", contains: false},
+		{time: "2024-02-06T08:40:00Z", contains: false},
+	} {
+		ts, err := time.Parse(time.RFC3339, tc.time)
+		require.NoError(t, err)
+		if tc.loc != nil {
+			ts = ts.In(tc.loc)
+		}
+		require.Equal(t, tc.contains, c.contains(ts), "%s in %s", tc.time, ts.Location())
+	}
+}
+
+func TestCalendarOccurrences(t *testing.T) {
+	events, err := parseCalendar([]byte(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:month-end
+DTSTART:20240131T220000Z
+DURATION:PT2H
+RRULE:FREQ=MONTHLY;COUNT=3
+END:VEVENT
+BEGIN:VEVENT
+UID:bridge-day
+DTSTART;VALUE=DATE:20240101
+RRULE:FREQ=YEARLY
+EXDATE;VALUE=DATE:20250101,20260101
+RDATE;VALUE=DATE:20250102
+END:VEVENT
+BEGIN:VEVENT
+UID:review
+DTSTART;TZID=Europe/Berlin:20240105T100000
+DTEND;TZID=Europe/Berlin:20240105T110000
+RRULE:FREQ=WEEKLY
+END:VEVENT
+BEGIN:VEVENT
+UID:review
+RECURRENCE-ID;TZID=Europe/Berlin:20240112T100000
+DTSTART;TZID=Europe/Berlin:20240111T150000
+DTEND;TZID=Europe/Berlin:20240111T160000
+END:VEVENT
+BEGIN:VEVENT
+UID:review
+RECURRENCE-ID;TZID=Europe/Berlin:20240119T100000
+DTSTART;TZID=Europe/Berlin:20240119T100000
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`))
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	c := Calendar{events: events}
+
+	for _, tc := range []struct {
+		time     string
+		contains bool
+	}{
+		// Monthly events skip the months too short for their day, which
+		// don't count towards the count of the rule.
+		{time: "2024-01-31T23:00:00Z", contains: true},
+		{time: "2024-03-02T23:00:00Z", contains: false},
+		{time: "2024-03-31T23:00:00Z", contains: true},
+		{time: "2024-04-30T23:00:00Z", contains: false},
+		{time: "2024-05-31T23:00:00Z", contains: true},
+		{time: "2024-07-31T23:00:00Z", contains: false},
+		// Excluded dates are skipped, and added dates recur as well.
+		{time: "2024-01-01T12:00:00Z", contains: true},
+		{time: "2025-01-01T12:00:00Z", contains: false},
+		{time: "2025-01-02T12:00:00Z", contains: true},
+		{time: "2026-01-01T12:00:00Z", contains: false},
+		{time: "2027-01-01T12:00:00Z", contains: true},
+		// Modified occurrences replace the original ones, and cancelled
+		// occurrences are skipped.
+		{time: "2024-01-05T09:30:00Z", contains: true},
+		{time: "2024-01-11T14:30:00Z", contains: true},
+		{time: "2024-01-12T09:30:00Z", contains: false},
+		{time: "2024-01-19T09:30:00Z", contains: false},
+		{time: "2024-01-26T09:30:00Z", contains: true},
+	} {
+		ts, err := time.Parse(time.RFC3339, tc.time)
+		require.NoError(t, err)
+		require.Equal(t, tc.contains, c.contains(ts), tc.time)
+	}
+}
+
+func TestParseCalendarErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		err  string
+	}{
+		{
+			name: "missing start",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 4: event "a": missing DTSTART`,
+		},
+		{
+			name: "unsupported recurrence",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART;VALUE=DATE:20241128\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 6: event "a": unsupported recurrence rule part "BYDAY=4TH"`,
+		},
+		{
+			name: "unsupported added period",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART;VALUE=DATE:20241128\nRDATE;VALUE=PERIOD:20241201T000000Z/PT1H\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 6: event "a": unsupported RDATE period "20241201T000000Z/PT1H"`,
+		},
+		{
+			name: "invalid excluded date",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART;VALUE=DATE:20241128\nEXDATE;VALUE=DATE:20241201,2024\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 6: event "a": parsing time "2024" as "20060102": cannot parse "" as "01"`,
+		},
+		{
+			name: "unsupported recurrence range",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nRECURRENCE-ID;RANGE=THISANDFUTURE:20241128T000000Z\nDTSTART:20241129T000000Z\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 6: event "a": unsupported RECURRENCE-ID range "THISANDFUTURE"`,
+		},
+		{
+			name: "end before start",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART:20240102T000000Z\nDTEND:20240101T000000Z\nEND:VEVENT\nEND:VCALENDAR\n",
+			err:  `line 6: event "a": end is before start`,
+		},
+		{
+			name: "unterminated component",
+			in:   "BEGIN:VCALENDAR\nBEGIN:VEVENT\n",
+			err:  "missing END:VEVENT",
+		},
+		{
+			name: "invalid content line",
+			in:   "BEGIN:VCALENDAR\nfoo\nEND:VCALENDAR\n",
+			err:  `line 2: invalid content line "foo"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseCalendar([]byte(tc.in))
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestCalendarLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "holidays.ics"), []byte(testCalendar), 0o644))
+
+	var ti TimeInterval
+	require.NoError(t, yaml.Unmarshal([]byte("calendars: ['holidays.ics']\nweekdays: ['monday:friday']\n"), &ti))
+	require.Len(t, ti.Calendars, 1)
+	ti.Calendars[0].SetDirectory(dir)
+	require.Equal(t, filepath.Join(dir, "holidays.ics"), ti.Calendars[0].Path)
+	require.NoError(t, ti.Calendars[0].Load())
+
+	// Calendars are combined with the other fields.
+	christmasEve, err := time.Parse(time.RFC3339, "2024-12-24T12:00:00Z")
+	require.NoError(t, err)
+	require.True(t, ti.ContainsTime(christmasEve))
+	saturday, err := time.Parse(time.RFC3339, "2024-12-28T12:00:00Z")
+	require.NoError(t, err)
+	require.False(t, ti.ContainsTime(saturday))
+	require.False(t, ti.ContainsTime(christmasEve.AddDate(0, 0, -7)))
+
+	out, err := yaml.Marshal(ti)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "calendars: ["+filepath.Join(dir, "holidays.ics")+"]")
+
+	ti.Calendars[0].Path = filepath.Join(dir, "missing.ics")
+	require.Error(t, ti.Calendars[0].Load())
+}
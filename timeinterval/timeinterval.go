@@ -65,6 +65,7 @@ type TimeInterval struct {
 	Months      []MonthRange      `yaml:"months,flow,omitempty" json:"months,omitempty"`
 	Years       []YearRange       `yaml:"years,flow,omitempty" json:"years,omitempty"`
 	Location    *Location         `yaml:"location,flow,omitempty" json:"location,omitempty"`
+	Calendars   []Calendar        `yaml:"calendars,flow,omitempty" json:"calendars,omitempty"`
 }
 
 // TimeRange represents a range of minutes within a 1440 minute day, exclusive of the End minute. A day consists of 1440 minutes.
@@ -572,6 +573,18 @@ func (tp TimeInterval) ContainsTime(t time.Time) bool {
 			return false
 		}
 	}
+	if tp.Calendars != nil {
+		in := false
+		for _, c := range tp.Calendars {
+			if c.contains(t) {
+				in = true
+				break
+			}
+		}
+		if !in {
+			return false
+		}
+	}
 	return true
 }
 
@@ -47,11 +47,30 @@ type AlertStatus struct {
 	SilencedBy  []string   `json:"silencedBy"`
 	InhibitedBy []string   `json:"inhibitedBy"`
 
+	// Acknowledgement is set if the alert is acknowledged.
+	Acknowledgement *Acknowledgement `json:"acknowledgement,omitempty"`
+
 	// For internal tracking, not exposed in the API.
 	pendingSilences []string
 	silencesVersion int
 }
 
+// Acknowledgement holds who acknowledged an alert, when and why. An
+// acknowledged alert is not notified again until it changes or resolves.
+type Acknowledgement struct {
+	AcknowledgedBy string    `json:"acknowledgedBy"`
+	Comment        string    `json:"comment,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	// ExpiresAt is the time at which the acknowledgement ends. The zero
+	// value means it never expires.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// Expired returns whether the acknowledgement has expired at the given time.
+func (a *Acknowledgement) Expired(now time.Time) bool {
+	return !a.ExpiresAt.IsZero() && !now.Before(a.ExpiresAt)
+}
+
 // Marker helps to mark alerts as silenced and/or inhibited.
 // All methods are goroutine-safe.
 type Marker interface {
@@ -71,6 +90,11 @@ type Marker interface {
 	// AlertStateActive. Otherwise, it sets the provided alert to
 	// AlertStateSuppressed.
 	SetInhibited(alert model.Fingerprint, alertIDs ...string)
+	// SetAcknowledged sets the acknowledgement of the given alert. A nil
+	// acknowledgement removes it. Acknowledgements don't change the
+	// AlertState and are kept when the alert is deleted, as they are
+	// managed independently of the alert's lifecycle.
+	SetAcknowledged(alert model.Fingerprint, ack *Acknowledgement)
 
 	// Count alerts of the given state(s). With no state provided, count all
 	// alerts.
@@ -90,12 +114,16 @@ type Marker interface {
 	Active(model.Fingerprint) bool
 	Silenced(model.Fingerprint) (activeIDs, pendingIDs []string, version int, silenced bool)
 	Inhibited(model.Fingerprint) ([]string, bool)
+	// Acknowledged returns the acknowledgement of the given alert and
+	// whether it is currently in effect.
+	Acknowledged(model.Fingerprint) (*Acknowledgement, bool)
 }
 
 // NewMarker returns an instance of a Marker implementation.
 func NewMarker(r prometheus.Registerer) Marker {
 	m := &memMarker{
-		m: map[model.Fingerprint]*AlertStatus{},
+		m:    map[model.Fingerprint]*AlertStatus{},
+		acks: map[model.Fingerprint]*Acknowledgement{},
 	}
 
 	m.registerMetrics(r)
@@ -104,7 +132,8 @@ func NewMarker(r prometheus.Registerer) Marker {
 }
 
 type memMarker struct {
-	m map[model.Fingerprint]*AlertStatus
+	m    map[model.Fingerprint]*AlertStatus
+	acks map[model.Fingerprint]*Acknowledgement
 
 	mtx sync.RWMutex
 }
@@ -205,14 +234,36 @@ func (m *memMarker) Status(alert model.Fingerprint) AlertStatus {
 	m.mtx.RLock()
 	defer m.mtx.RUnlock()
 
-	if s, found := m.m[alert]; found {
-		return *s
-	}
-	return AlertStatus{
+	status := AlertStatus{
 		State:       AlertStateUnprocessed,
 		SilencedBy:  []string{},
 		InhibitedBy: []string{},
 	}
+	if s, found := m.m[alert]; found {
+		status = *s
+	}
+	if ack, found := m.acks[alert]; found && !ack.Expired(time.Now()) {
+		status.Acknowledgement = ack
+	}
+	return status
+}
+
+// SetAcknowledged implements Marker.
+func (m *memMarker) SetAcknowledged(alert model.Fingerprint, ack *Acknowledgement) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if ack == nil {
+		delete(m.acks, alert)
+		return
+	}
+	m.acks[alert] = ack
+}
+
+// Acknowledged implements Marker.
+func (m *memMarker) Acknowledged(alert model.Fingerprint) (*Acknowledgement, bool) {
+	ack := m.Status(alert).Acknowledgement
+	return ack, ack != nil
 }
 
 // Delete implements Marker.